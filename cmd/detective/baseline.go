@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/detective-cli/detective/internal/inference"
+	"github.com/detective-cli/detective/internal/policy"
+	"github.com/detective-cli/detective/internal/reporter"
+	"github.com/detective-cli/detective/pkg/models"
+	"github.com/detective-cli/detective/pkg/report/sarif"
+)
+
+// loadBaselineReport reads a previous --format json report from path, for
+// use as the --baseline argument's comparison point.
+func loadBaselineReport(path string) (models.Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return models.Report{}, err
+	}
+	var report models.Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return models.Report{}, fmt.Errorf("parsing baseline report %s: %w", path, err)
+	}
+	return report, nil
+}
+
+// parseSeverityThreshold parses a --baseline-severity value (low, medium,
+// high, critical; case-insensitive) into a models.Severity.
+func parseSeverityThreshold(s string) (models.Severity, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "low":
+		return models.SeverityLow, nil
+	case "medium":
+		return models.SeverityMedium, nil
+	case "high":
+		return models.SeverityHigh, nil
+	case "critical":
+		return models.SeverityCritical, nil
+	default:
+		return 0, fmt.Errorf("unknown --baseline-severity %q", s)
+	}
+}
+
+// runBaselineGate loads the previous scan at baselinePath, renders a delta
+// report against curr in diffFormat (text, json, or sarif), prints it, and
+// returns an error - so main exits non-zero - when curr introduced a new
+// finding at or above severityThreshold, or when policyDecision (nil if
+// --detective.yaml evaluation is disabled or found no policy file) failed
+// its own .detective.yaml evaluation. Resolved, persisted, and
+// severity-changed findings never fail the baseline half of the gate; only
+// genuinely new regressions do, so Detective can gate PRs on regressions
+// instead of absolute counts - but a failing policy still fails the run,
+// the same as it would without --baseline.
+func runBaselineGate(curr models.Report, baselinePath, diffFormat, severityThreshold string, colorEnabled bool, policyDecision *policy.Decision) error {
+	prev, err := loadBaselineReport(baselinePath)
+	if err != nil {
+		return fmt.Errorf("loading baseline report: %w", err)
+	}
+
+	threshold, err := parseSeverityThreshold(severityThreshold)
+	if err != nil {
+		return err
+	}
+
+	diff := inference.DiffFindings(prev.Findings, curr.Findings)
+
+	switch diffFormat {
+	case "json":
+		if err := writeDiffJSON(os.Stdout, diff); err != nil {
+			return fmt.Errorf("writing diff JSON: %w", err)
+		}
+	case "sarif":
+		if err := sarif.WriteDiffSARIF(os.Stdout, diff, curr.Evidence); err != nil {
+			return fmt.Errorf("writing diff SARIF: %w", err)
+		}
+	default:
+		fmt.Println(reporter.GenerateDiffReportStyled(prev, curr, colorEnabled))
+	}
+
+	var regressions int
+	for _, f := range diff.Added {
+		if f.Severity >= threshold {
+			regressions++
+		}
+	}
+	if regressions > 0 {
+		return fmt.Errorf("%d new finding(s) at or above severity %s", regressions, threshold)
+	}
+	if policyDecision != nil && policyDecision.Fail {
+		return fmt.Errorf("policy violation: %d finding(s) failed .detective.yaml", len(policyDecision.Violations))
+	}
+	return nil
+}
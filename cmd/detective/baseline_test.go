@@ -0,0 +1,213 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/detective-cli/detective/internal/policy"
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestParseSeverityThreshold(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    models.Severity
+		wantErr bool
+	}{
+		{"low", models.SeverityLow, false},
+		{" Medium ", models.SeverityMedium, false},
+		{"HIGH", models.SeverityHigh, false},
+		{"critical", models.SeverityCritical, false},
+		{"ludicrous", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseSeverityThreshold(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseSeverityThreshold(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("parseSeverityThreshold(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLoadBaselineReportRoundTrip(t *testing.T) {
+	report := models.Report{HealthScore: 80, TargetPath: "/repo"}
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	got, err := loadBaselineReport(path)
+	if err != nil {
+		t.Fatalf("loadBaselineReport: %v", err)
+	}
+	if got.HealthScore != 80 || got.TargetPath != "/repo" {
+		t.Errorf("loadBaselineReport = %+v, want HealthScore=80 TargetPath=/repo", got)
+	}
+}
+
+func TestLoadBaselineReportMissingFile(t *testing.T) {
+	if _, err := loadBaselineReport(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("loadBaselineReport with a missing file returned nil error")
+	}
+}
+
+func TestLoadBaselineReportInvalidJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(path, []byte("not json"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if _, err := loadBaselineReport(path); err == nil {
+		t.Error("loadBaselineReport with malformed JSON returned nil error")
+	}
+}
+
+func withCapturedStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	old := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = old }()
+
+	fn()
+
+	w.Close()
+	os.Stdout = old
+
+	buf := make([]byte, 64*1024)
+	n, _ := r.Read(buf)
+	return string(buf[:n])
+}
+
+func TestRunBaselineGateFailsOnNewRegressionAboveThreshold(t *testing.T) {
+	prev := models.Report{Findings: []models.Finding{}}
+	data, err := json.Marshal(prev)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	curr := models.Report{
+		Findings: []models.Finding{
+			{Title: "new critical finding", Severity: models.SeverityCritical},
+		},
+	}
+
+	var gateErr error
+	withCapturedStdout(t, func() {
+		gateErr = runBaselineGate(curr, path, "text", "high", false, nil)
+	})
+	if gateErr == nil {
+		t.Error("runBaselineGate should fail when a new finding is at or above the threshold")
+	}
+}
+
+func TestRunBaselineGatePassesWhenNewFindingBelowThreshold(t *testing.T) {
+	prev := models.Report{Findings: []models.Finding{}}
+	data, err := json.Marshal(prev)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	curr := models.Report{
+		Findings: []models.Finding{
+			{Title: "new low finding", Severity: models.SeverityLow},
+		},
+	}
+
+	var gateErr error
+	output := withCapturedStdout(t, func() {
+		gateErr = runBaselineGate(curr, path, "json", "high", false, nil)
+	})
+	if gateErr != nil {
+		t.Errorf("runBaselineGate returned %v, want nil (new finding is below threshold)", gateErr)
+	}
+
+	var diff models.FindingsDiff
+	if err := json.Unmarshal([]byte(output), &diff); err != nil {
+		t.Fatalf("diffFormat=json output is not valid JSON: %v\noutput: %s", err, output)
+	}
+	if len(diff.Added) != 1 || diff.Added[0].Title != "new low finding" {
+		t.Errorf("diff.Added = %+v, want the one new finding", diff.Added)
+	}
+}
+
+func TestRunBaselineGateUnknownSeverityThreshold(t *testing.T) {
+	prev := models.Report{}
+	data, err := json.Marshal(prev)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if err := runBaselineGate(models.Report{}, path, "text", "ludicrous", false, nil); err == nil {
+		t.Error("runBaselineGate with an invalid --baseline-severity returned nil error")
+	}
+}
+
+func TestRunBaselineGateFailsOnPolicyViolationEvenWithoutRegression(t *testing.T) {
+	prev := models.Report{Findings: []models.Finding{}}
+	data, err := json.Marshal(prev)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	curr := models.Report{Findings: []models.Finding{}}
+	failingDecision := &policy.Decision{Fail: true, Violations: []models.Finding{{Title: "policy violation"}}}
+
+	var gateErr error
+	withCapturedStdout(t, func() {
+		gateErr = runBaselineGate(curr, path, "text", "critical", false, failingDecision)
+	})
+	if gateErr == nil {
+		t.Error("runBaselineGate should fail when policyDecision.Fail is true, even with no baseline regression")
+	}
+}
+
+func TestRunBaselineGatePassesWhenPolicyDecisionDoesNotFail(t *testing.T) {
+	prev := models.Report{Findings: []models.Finding{}}
+	data, err := json.Marshal(prev)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	curr := models.Report{Findings: []models.Finding{}}
+	passingDecision := &policy.Decision{Fail: false}
+
+	var gateErr error
+	withCapturedStdout(t, func() {
+		gateErr = runBaselineGate(curr, path, "text", "critical", false, passingDecision)
+	})
+	if gateErr != nil {
+		t.Errorf("runBaselineGate returned %v, want nil (policyDecision.Fail is false)", gateErr)
+	}
+}
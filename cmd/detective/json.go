@@ -0,0 +1,22 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func writeJSON(w io.Writer, report models.Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+// writeDiffJSON serializes a models.FindingsDiff (see --baseline) as JSON,
+// the same way writeJSON does for a full report.
+func writeDiffJSON(w io.Writer, diff models.FindingsDiff) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(diff)
+}
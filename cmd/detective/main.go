@@ -0,0 +1,477 @@
+// Command detective investigates a codebase and reports on its health.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/detective-cli/detective/internal/detector"
+	"github.com/detective-cli/detective/internal/git"
+	"github.com/detective-cli/detective/internal/inference"
+	"github.com/detective-cli/detective/internal/inference/probes"
+	"github.com/detective-cli/detective/internal/pipeline"
+	"github.com/detective-cli/detective/internal/policy"
+	"github.com/detective-cli/detective/internal/progress"
+	"github.com/detective-cli/detective/internal/reporter"
+	"github.com/detective-cli/detective/internal/scanner"
+	"github.com/detective-cli/detective/internal/security"
+	"github.com/detective-cli/detective/internal/vulns"
+	"github.com/detective-cli/detective/internal/vulnsrc"
+	"github.com/detective-cli/detective/pkg/models"
+	"github.com/detective-cli/detective/pkg/relnotes"
+	"github.com/detective-cli/detective/pkg/report/export"
+	"github.com/detective-cli/detective/pkg/report/sarif"
+)
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "suppress":
+			if err := runSuppress(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, "detective:", err)
+				os.Exit(1)
+			}
+			return
+		case "unsuppress":
+			if err := runUnsuppress(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, "detective:", err)
+				os.Exit(1)
+			}
+			return
+		case "triage":
+			if err := runTriage(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, "detective:", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	var (
+		format           = flag.String("format", "text", "output format: text, json, sarif")
+		scoringContext   = flag.String("context", "default", "scoring context: default, student, enterprise")
+		noColor          = flag.Bool("no-color", false, "disable colored text output")
+		rules            = flag.String("rules", "", "path to a supplementary YAML rule pack (see internal/security/rules)")
+		workers          = flag.Int("workers", 0, "security scan worker pool size (default: number of CPUs)")
+		noCache          = flag.Bool("no-cache", false, "bypass the security scan cache at .detective/cache.json")
+		since            = flag.String("since", "", "only rescan files changed since this git ref; others are served from cache")
+		skipVulnDep      = flag.Bool("skip-vuln-scan", false, "skip the OSV.dev dependency vulnerability lookup (no network calls)")
+		osvOfflineDir    = flag.String("osv-offline-dir", "", "match dependencies against a pre-downloaded OSV.dev dump directory instead of the live API (no network calls)")
+		probesDir        = flag.String("probes-dir", "", "directory of custom YAML probes to evaluate alongside the built-in findings")
+		vulnsrcCache     = flag.String("vulnsrc-cache", "", "enable NVD/Ubuntu/GHSA CVE cross-referencing, caching fetched advisories under this directory")
+		releaseFrom      = flag.String("release-from", "", "generate release notes for commits after this git ref (requires -release-to)")
+		releaseTo        = flag.String("release-to", "HEAD", "generate release notes for commits up to and including this git ref")
+		showProgress     = flag.Bool("progress", false, "show a terminal progress bar for each scan phase on stderr")
+		jobs             = flag.Int("jobs", 0, "max concurrent analysis passes/probes (default: number of CPUs)")
+		noDiff           = flag.Bool("no-diff", false, "skip comparing findings against .detective/last-scan.json")
+		stateFilter      = flag.String("state", "", "only report findings in these comma-separated triage states (open,confirmed,dismissed,resolved); empty means all")
+		noGitignore      = flag.Bool("no-gitignore", false, "don't skip paths matched by .gitignore, nested .gitignore, or .git/info/exclude")
+		noLinguist       = flag.Bool("no-linguist-overrides", false, "don't recategorize .gitattributes linguist-generated/linguist-vendored paths")
+		rebuildCache     = flag.Bool("rebuild-cache", false, "ignore the scan cache at .detective/scan-cache.json for this run, but still refresh it")
+		activityWindow   = flag.Duration("activity-window", 90*24*time.Hour, "how far back to look for the git churn/bus-factor analysis")
+		complexityMax    = flag.Int("complexity-threshold", 0, "cognitive complexity above which a function is reported as a code smell (default 15)")
+		reportFormats    = flag.String("report-formats", "", "comma-separated list of additional formats to write under --report-dir: "+strings.Join(export.Formats(), ", "))
+		reportDir        = flag.String("report-dir", "detective-reports", "output directory for --report-formats")
+		prettyFormat     = flag.String("pretty-format", "", "render findings through a git-log-style format string instead of --format output, e.g. '%sC[%>(8)%S]%Creset %t (%p)' (see internal/reporter.FormatTemplate for placeholders)")
+		baseline         = flag.String("baseline", "", "path to a previous --format json report; when set, print a delta report against it instead of the full report, and exit non-zero on new findings at/above --baseline-severity")
+		baselineSeverity = flag.String("baseline-severity", "high", "minimum severity a new finding needs to fail --baseline (low, medium, high, critical)")
+		baselineFormat   = flag.String("baseline-format", "text", "delta report format for --baseline: text, json, sarif")
+		noPolicy         = flag.Bool("no-policy", false, "don't evaluate .detective.yaml, if present (see internal/policy)")
+	)
+	flag.Parse()
+
+	target := "."
+	if flag.NArg() > 0 {
+		target = flag.Arg(0)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var prog progress.Reporter = progress.Noop
+	if *showProgress {
+		prog = progress.NewTerminal(os.Stderr)
+	}
+
+	states, err := parseStates(*stateFilter)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "detective:", err)
+		os.Exit(1)
+	}
+
+	if err := run(ctx, prog, target, *format, *scoringContext, *rules, *workers, *jobs, *noCache, *since, *skipVulnDep, *probesDir, *vulnsrcCache, *releaseFrom, *releaseTo, !*noColor, *noDiff, states, !*noGitignore, !*noLinguist, *rebuildCache, *activityWindow, *complexityMax, *osvOfflineDir, *reportFormats, *reportDir, *prettyFormat, *baseline, *baselineSeverity, *baselineFormat, !*noPolicy); err != nil {
+		fmt.Fprintln(os.Stderr, "detective:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, prog progress.Reporter, target, format, scoringContext, rulesPack string, workers, jobs int, noCache bool, since string, skipVulnDep bool, probesDir, vulnsrcCache, releaseFrom, releaseTo string, colorEnabled, noDiff bool, states []models.FindingState, respectGitignore, linguistOverride, rebuildCache bool, activityWindow time.Duration, complexityThreshold int, osvOfflineDir, reportFormats, reportDir, prettyFormat, baseline, baselineSeverity, baselineFormat string, evaluatePolicy bool) error {
+	opts := scanner.ScanOptions{
+		ExcludeDirs:                   []string{"node_modules", "vendor", ".git", "dist", "build"},
+		SkipHidden:                    true,
+		RespectGitignore:              respectGitignore,
+		GitattributesLinguistOverride: linguistOverride,
+		ComplexityThreshold:           complexityThreshold,
+	}
+
+	var scanCache *scanner.FileCache
+	if !noCache {
+		scanCache = scanner.LoadFileCache(filepath.Join(target, ".detective"))
+		opts.Cache = scanCache
+		if rebuildCache {
+			opts.Cache = scanner.RebuildCache(scanCache)
+		}
+	}
+
+	prog.Start("filesystem", 1)
+	fsEvidence, markers, timeline, err := scanner.RunWalk(ctx, target, opts, jobs)
+	if err != nil {
+		return fmt.Errorf("walking file system: %w", err)
+	}
+	prog.Increment(1)
+	prog.Done()
+
+	prog.Start("code-smells", 1)
+	smells, err := scanner.ScanCodeSmells(target, opts)
+	if err != nil {
+		return fmt.Errorf("scanning code smells: %w", err)
+	}
+	prog.Increment(1)
+	prog.Done()
+
+	if scanCache != nil {
+		if err := scanCache.Save(filepath.Join(target, ".detective")); err != nil {
+			return fmt.Errorf("saving scan cache: %w", err)
+		}
+	}
+
+	prog.Start("git", 1)
+	var gitStatsCache *git.CommitStatsCache
+	if !noCache {
+		gitStatsCache = git.LoadCommitStatsCache(filepath.Join(target, ".detective"))
+	}
+	gitEvidence, err := git.AnalyzeRepository(ctx, target, activityWindow, gitStatsCache)
+	if err != nil {
+		return fmt.Errorf("analyzing git history: %w", err)
+	}
+	if gitStatsCache != nil {
+		if err := gitStatsCache.Save(filepath.Join(target, ".detective")); err != nil {
+			return fmt.Errorf("saving git stats cache: %w", err)
+		}
+	}
+	prog.Increment(1)
+	prog.Done()
+
+	prog.Start("security", 1)
+	secEvidence, err := pipeline.Run(target, pipeline.ScanOptions{
+		ExcludeDirs: opts.ExcludeDirs,
+		Workers:     workers,
+		NoCache:     noCache,
+		Since:       since,
+		Config:      security.DefaultSecretScanConfig(),
+	})
+	if err != nil {
+		return fmt.Errorf("scanning for security issues: %w", err)
+	}
+
+	ruleEvidence, err := scanWithRulePack(target, opts.ExcludeDirs, rulesPack)
+	if err != nil {
+		return fmt.Errorf("running rule pack: %w", err)
+	}
+	security.MergeEvidence(&secEvidence, ruleEvidence)
+
+	taintRisks, err := security.AnalyzeTaint(target, opts.ExcludeDirs)
+	if err != nil {
+		return fmt.Errorf("running taint analysis: %w", err)
+	}
+	for _, risk := range taintRisks {
+		if risk.Type == "sql-injection" {
+			secEvidence.SQLInjectionRisks = append(secEvidence.SQLInjectionRisks, risk)
+		} else {
+			secEvidence.XSSRisks = append(secEvidence.XSSRisks, risk)
+		}
+	}
+
+	dockerRisks, err := vulns.ScanDockerfiles(target, opts.ExcludeDirs)
+	if err != nil {
+		return fmt.Errorf("scanning Dockerfiles: %w", err)
+	}
+	secEvidence.InsecurePatterns = append(secEvidence.InsecurePatterns, dockerRisks...)
+	prog.Increment(1)
+	prog.Done()
+
+	prog.Start("cve-lookup", 1)
+	if !skipVulnDep {
+		if osvOfflineDir != "" {
+			depFindings, err := scanDependencyVulnerabilitiesOffline(target, opts.ExcludeDirs, osvOfflineDir)
+			if err != nil {
+				return fmt.Errorf("scanning dependency vulnerabilities: %w", err)
+			}
+			secEvidence.VulnerableDependencies = depFindings
+		} else {
+			var osvCache *vulns.OSVCache
+			if !noCache {
+				osvCache = vulns.LoadOSVCache(filepath.Join(target, ".detective"))
+			}
+			depFindings, err := scanDependencyVulnerabilities(ctx, target, opts.ExcludeDirs, osvCache)
+			if err != nil {
+				return fmt.Errorf("scanning dependency vulnerabilities: %w", err)
+			}
+			secEvidence.VulnerableDependencies = depFindings
+			if osvCache != nil {
+				if err := osvCache.Save(filepath.Join(target, ".detective")); err != nil {
+					return fmt.Errorf("saving OSV cache: %w", err)
+				}
+			}
+		}
+	}
+
+	if vulnsrcCache != "" {
+		crossRefFindings, err := crossReferenceKnownVulnerabilities(ctx, target, opts.ExcludeDirs, vulnsrcCache)
+		if err != nil {
+			return fmt.Errorf("cross-referencing CVE trackers: %w", err)
+		}
+		secEvidence.VulnerableDependencies = append(secEvidence.VulnerableDependencies, crossRefFindings...)
+	}
+	prog.Increment(1)
+	prog.Done()
+
+	evidence := models.Evidence{
+		FileSystem:  fsEvidence,
+		Git:         gitEvidence,
+		CodeMarkers: markers,
+		CodeSmells:  smells,
+		Timeline:    timeline,
+		ProjectType: detector.DetectProjectType(target),
+		Security:    secEvidence,
+	}
+	evidence.InvestigatorNotes, err = inference.GenerateInvestigatorNotes(ctx, evidence)
+	if err != nil {
+		return fmt.Errorf("generating investigator notes: %w", err)
+	}
+
+	allFindings, err := inference.GenerateFindingsEnhanced(ctx, evidence, jobs)
+	if err != nil {
+		return fmt.Errorf("generating findings: %w", err)
+	}
+	if probesDir != "" {
+		customFindings, err := runCustomProbes(ctx, evidence, probesDir, jobs)
+		if err != nil {
+			return fmt.Errorf("running custom probes: %w", err)
+		}
+		allFindings = append(allFindings, customFindings...)
+	}
+
+	findings := inference.ContextualizeFindings(allFindings, scoringContext)
+	findings = inference.SignFindings(findings)
+
+	detectiveDir := filepath.Join(target, ".detective")
+
+	suppressions, err := inference.LoadSuppressions(detectiveDir)
+	if err != nil {
+		return fmt.Errorf("loading suppressions: %w", err)
+	}
+	findings = inference.ApplySuppressions(findings, suppressions)
+	findings = inference.ApplyTriage(findings, inference.LoadTriage(detectiveDir))
+
+	var policyDecision *policy.Decision
+	if evaluatePolicy {
+		pol, err := policy.LoadPolicy(target)
+		if err != nil {
+			return fmt.Errorf("loading policy: %w", err)
+		}
+		if pol != nil {
+			decision, err := pol.Evaluate(findings, time.Now())
+			if err != nil {
+				return fmt.Errorf("evaluating policy: %w", err)
+			}
+			findings = decision.Findings
+			policyDecision = &decision
+		}
+	}
+
+	healthScore, breakdown, err := inference.CalculateHealthScoreWeighted(ctx, findings, evidence)
+	if err != nil {
+		return fmt.Errorf("calculating health score: %w", err)
+	}
+
+	if !noDiff {
+		if err := reportFindingsDiff(target, findings); err != nil {
+			return fmt.Errorf("diffing against last scan: %w", err)
+		}
+	}
+
+	if len(states) > 0 {
+		findings = inference.FilterByState(findings, states)
+	}
+
+	report := models.Report{
+		TargetPath:      target,
+		InvestigatedAt:  time.Now(),
+		Evidence:        evidence,
+		Findings:        findings,
+		HealthScore:     healthScore,
+		HealthBreakdown: breakdown,
+		Context:         scoringContext,
+		Status:          models.ReportStatusSuccess,
+	}
+
+	if releaseFrom != "" {
+		notes, err := relnotes.GenerateReleaseNotes(evidence, releaseFrom, releaseTo)
+		if err != nil {
+			return fmt.Errorf("generating release notes: %w", err)
+		}
+		report.ReleaseNotes = notes
+	}
+
+	report.ReportHash = inference.ComputeReportHash(report)
+
+	if reportFormats != "" {
+		if err := writeReportExports(report, reportDir, reportFormats); err != nil {
+			return fmt.Errorf("writing report exports: %w", err)
+		}
+	}
+
+	if prettyFormat != "" {
+		fmt.Println(reporter.FormatTemplate(report, prettyFormat))
+		return nil
+	}
+
+	if baseline != "" {
+		return runBaselineGate(report, baseline, baselineFormat, baselineSeverity, colorEnabled, policyDecision)
+	}
+
+	var writeErr error
+	switch format {
+	case "sarif":
+		writeErr = sarif.WriteSARIF(os.Stdout, findings, evidence)
+	case "json":
+		writeErr = writeJSON(os.Stdout, report)
+	default:
+		fmt.Println(reporter.GenerateReportStyled(report, colorEnabled))
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+
+	if policyDecision != nil && policyDecision.Fail {
+		return fmt.Errorf("policy violation: %d finding(s) failed .detective.yaml", len(policyDecision.Violations))
+	}
+	return nil
+}
+
+// scanWithRulePack runs the bundled default rule pack, plus rulesPack if
+// one was given via --rules, and returns the resulting security evidence.
+func scanWithRulePack(target string, excludeDirs []string, rulesPack string) (models.SecurityEvidence, error) {
+	engine, err := security.NewRuleEngine()
+	if err != nil {
+		return models.SecurityEvidence{}, err
+	}
+	if rulesPack != "" {
+		if err := engine.LoadPack(rulesPack); err != nil {
+			return models.SecurityEvidence{}, err
+		}
+	}
+	return security.ScanSecurityWithRules(target, excludeDirs, engine)
+}
+
+// scanDependencyVulnerabilities parses declared dependencies from any
+// lockfiles under target and cross-references them against OSV.dev. ctx
+// bounds the OSV.dev HTTP calls. Results are cached under the same
+// .detective directory as the other local caches; pass a nil osvCache to
+// bypass it entirely (the --no-cache case).
+func scanDependencyVulnerabilities(ctx context.Context, target string, excludeDirs []string, osvCache *vulns.OSVCache) ([]models.DependencyFinding, error) {
+	packages, err := vulns.ParseDependencies(target, excludeDirs)
+	if err != nil {
+		return nil, err
+	}
+	if len(packages) == 0 {
+		return nil, nil
+	}
+	return vulns.QueryOSV(ctx, packages, osvCache)
+}
+
+// scanDependencyVulnerabilitiesOffline is the --osv-offline-dir counterpart
+// to scanDependencyVulnerabilities: it matches declared dependencies
+// against a pre-downloaded OSV.dev dump directory instead of the live API,
+// so it makes no network calls at all.
+func scanDependencyVulnerabilitiesOffline(target string, excludeDirs []string, dumpDir string) ([]models.DependencyFinding, error) {
+	packages, err := vulns.ParseDependencies(target, excludeDirs)
+	if err != nil {
+		return nil, err
+	}
+	if len(packages) == 0 {
+		return nil, nil
+	}
+	return vulns.QueryOSVOffline(dumpDir, packages)
+}
+
+// crossReferenceKnownVulnerabilities correlates declared dependencies
+// against NVD, the Ubuntu CVE Tracker, and the GitHub Advisory Database,
+// caching each source's fetched data under cacheDir so a later offline run
+// still has it. This is additive to the OSV.dev-backed
+// scanDependencyVulnerabilities: the sources overlap but neither is a
+// strict subset of the other. ctx bounds each source's update request.
+func crossReferenceKnownVulnerabilities(ctx context.Context, target string, excludeDirs []string, cacheDir string) ([]models.DependencyFinding, error) {
+	packages, err := vulns.ParseDependencies(target, excludeDirs)
+	if err != nil {
+		return nil, err
+	}
+	if len(packages) == 0 {
+		return nil, nil
+	}
+
+	store := vulnsrc.NewStore(cacheDir)
+	updaters := []vulnsrc.Updater{
+		&vulnsrc.NVDUpdater{},
+		&vulnsrc.GHSAUpdater{},
+		&vulnsrc.UbuntuCVETrackerUpdater{CheckoutDir: filepath.Join(cacheDir, "ubuntu-cve-tracker")},
+	}
+	vulnerabilities, err := store.Refresh(ctx, updaters)
+	if err != nil {
+		return nil, err
+	}
+
+	return vulnsrc.Correlate(packages, vulnerabilities), nil
+}
+
+// reportFindingsDiff compares findings against the previous run's signed
+// findings at target/.detective/last-scan.json (see pipeline.Run's use of
+// the same .detective directory for its security scan cache), prints a
+// one-line summary of what's new/resolved since then to stderr, and
+// persists findings as the new last-scan snapshot for the next run.
+// Nothing is printed on a first run, since there's nothing to diff against.
+func reportFindingsDiff(target string, findings []models.Finding) error {
+	lastScanDir := filepath.Join(target, ".detective")
+
+	if previous := inference.LoadLastScan(lastScanDir); previous != nil {
+		diff := inference.DiffFindings(previous, findings)
+		if len(diff.Added) > 0 || len(diff.Resolved) > 0 {
+			fmt.Fprintf(os.Stderr, "%d new findings since last run, %d resolved\n", len(diff.Added), len(diff.Resolved))
+		}
+	}
+
+	return inference.SaveLastScan(lastScanDir, findings)
+}
+
+// runCustomProbes evaluates only the organization-specific probes loaded
+// from probesDir; the built-in checks are already covered by
+// inference.GenerateFindingsEnhanced, so the engine here starts empty
+// rather than with its default probe set.
+func runCustomProbes(ctx context.Context, evidence models.Evidence, probesDir string, jobs int) ([]models.Finding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	engine := &probes.Engine{}
+	if err := engine.LoadDir(probesDir); err != nil {
+		return nil, err
+	}
+	return engine.Run(ctx, evidence, jobs)
+}
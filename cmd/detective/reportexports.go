@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/detective-cli/detective/pkg/models"
+	"github.com/detective-cli/detective/pkg/report/export"
+)
+
+// writeReportExports writes report under dir in every format named in the
+// comma-separated formatsList (see pkg/report/export.Formats for the
+// supported names), plus an index.md linking each one. This is what lets a
+// single detective invocation feed a GitLab SAST widget, a code-scanning
+// tab, and a JUnit reporter in the same CI run.
+func writeReportExports(report models.Report, dir, formatsList string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	baseName := filepath.Base(report.TargetPath)
+	if baseName == "" || baseName == "." || baseName == string(filepath.Separator) {
+		baseName = "report"
+	}
+
+	var links []string
+	for _, name := range strings.Split(formatsList, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		exporter, ext, ok := export.Lookup(name)
+		if !ok {
+			return export.UnknownFormatError{Format: name}
+		}
+
+		fileName := baseName + ext
+		if err := writeReportExport(exporter, report, filepath.Join(dir, fileName)); err != nil {
+			return fmt.Errorf("writing %s report: %w", name, err)
+		}
+		links = append(links, fmt.Sprintf("- [%s](%s)", name, fileName))
+	}
+
+	index := "# Detective Reports\n\n" + strings.Join(links, "\n") + "\n"
+	return os.WriteFile(filepath.Join(dir, "index.md"), []byte(index), 0o644)
+}
+
+func writeReportExport(exporter export.Exporter, report models.Report, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	exportErr := exporter.Export(report, f)
+	closeErr := f.Close()
+	if exportErr != nil {
+		return exportErr
+	}
+	return closeErr
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/detective-cli/detective/internal/inference"
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// runSuppress implements `detective suppress --reason "..." [--type
+// falsePositive|acceptedRisk|wontFix] [--author name] <signature>
+// [target]`, recording a disposition for a finding signature (see
+// models.FindingSignature) in target/.detective/suppressions.yml (target
+// defaults to "."). A later scan of the same target attaches it via
+// inference.ApplySuppressions. Re-suppressing an already-suppressed
+// signature replaces its entry rather than appending a duplicate.
+func runSuppress(args []string) error {
+	fs := flag.NewFlagSet("suppress", flag.ContinueOnError)
+	reason := fs.String("reason", "", "why this finding is being suppressed (required)")
+	flagType := fs.String("type", string(models.FlagFalsePositive), "falsePositive, acceptedRisk, or wontFix")
+	author := fs.String("author", "", "who is suppressing this finding")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() < 1 {
+		return fmt.Errorf(`usage: detective suppress --reason "..." <signature> [target]`)
+	}
+	if *reason == "" {
+		return fmt.Errorf("suppress: --reason is required")
+	}
+
+	switch models.FindingFlagType(*flagType) {
+	case models.FlagFalsePositive, models.FlagAcceptedRisk, models.FlagWontFix:
+	default:
+		return fmt.Errorf("suppress: unknown --type %q", *flagType)
+	}
+
+	signature, target := fs.Arg(0), suppressTarget(fs)
+
+	dir := filepath.Join(target, ".detective")
+	suppressions, err := inference.LoadSuppressions(dir)
+	if err != nil {
+		return err
+	}
+
+	suppressions = append(withoutSignature(suppressions, signature), inference.Suppression{
+		Signature: signature,
+		Type:      models.FindingFlagType(*flagType),
+		Reason:    *reason,
+		Author:    *author,
+		CreatedAt: time.Now(),
+	})
+
+	return inference.SaveSuppressions(dir, suppressions)
+}
+
+// runUnsuppress implements `detective unsuppress <signature> [target]`,
+// removing signature's entry from target/.detective/suppressions.yml. It
+// is not an error to unsuppress a signature with no existing entry.
+func runUnsuppress(args []string) error {
+	fs := flag.NewFlagSet("unsuppress", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 1 {
+		return fmt.Errorf("usage: detective unsuppress <signature> [target]")
+	}
+
+	signature, target := fs.Arg(0), suppressTarget(fs)
+
+	dir := filepath.Join(target, ".detective")
+	suppressions, err := inference.LoadSuppressions(dir)
+	if err != nil {
+		return err
+	}
+
+	return inference.SaveSuppressions(dir, withoutSignature(suppressions, signature))
+}
+
+// suppressTarget returns fs's second positional argument (the scan
+// target), defaulting to "." when it wasn't given.
+func suppressTarget(fs *flag.FlagSet) string {
+	if fs.NArg() > 1 {
+		return fs.Arg(1)
+	}
+	return "."
+}
+
+// withoutSignature returns suppressions with any entry for signature
+// removed.
+func withoutSignature(suppressions []inference.Suppression, signature string) []inference.Suppression {
+	filtered := suppressions[:0]
+	for _, s := range suppressions {
+		if s.Signature != signature {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/detective-cli/detective/internal/inference"
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// runTriage implements `detective triage <state> <signature> [--reason
+// "..."] [target]`, recording a lifecycle transition for a finding
+// signature (see models.FindingSignature) in
+// target/.detective/triage.json (target defaults to "."). A later scan of
+// the same target attaches it via inference.ApplyTriage.
+func runTriage(args []string) error {
+	fs := flag.NewFlagSet("triage", flag.ContinueOnError)
+	reason := fs.String("reason", "", "why this finding is transitioning to the given state")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() < 2 {
+		return fmt.Errorf("usage: detective triage <open|confirmed|dismissed|resolved> <signature> [target]")
+	}
+
+	state := models.FindingState(fs.Arg(0))
+	switch state {
+	case models.StateOpen, models.StateConfirmed, models.StateDismissed, models.StateResolved:
+	default:
+		return fmt.Errorf("triage: unknown state %q", fs.Arg(0))
+	}
+
+	signature, target := fs.Arg(1), triageTarget(fs)
+
+	dir := filepath.Join(target, ".detective")
+	records := inference.LoadTriage(dir)
+	records = inference.SetTriageState(records, signature, state, *reason)
+
+	return inference.SaveTriage(dir, records)
+}
+
+// triageTarget returns fs's third positional argument (the scan target),
+// defaulting to "." when it wasn't given.
+func triageTarget(fs *flag.FlagSet) string {
+	if fs.NArg() > 2 {
+		return fs.Arg(2)
+	}
+	return "."
+}
+
+// parseStates splits csv (as given to the top-level --state flag) into the
+// models.FindingState values it names, returning nil for an empty csv so
+// callers can treat that as "no filter".
+func parseStates(csv string) ([]models.FindingState, error) {
+	if csv == "" {
+		return nil, nil
+	}
+
+	var states []models.FindingState
+	for _, part := range strings.Split(csv, ",") {
+		state := models.FindingState(strings.TrimSpace(part))
+		switch state {
+		case models.StateOpen, models.StateConfirmed, models.StateDismissed, models.StateResolved:
+		default:
+			return nil, fmt.Errorf("unknown --state %q", part)
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
@@ -0,0 +1,103 @@
+package git
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// FileStat is one file's line-count delta within a single commit, cached
+// verbatim from object.Commit.Stats().
+type FileStat struct {
+	Name     string `json:"name"`
+	Addition int    `json:"addition"`
+	Deletion int    `json:"deletion"`
+}
+
+const commitStatsCacheFileName = "git-stats-cache.json"
+
+// CommitStatsCache is a JSON-backed cache of per-commit diff stats, keyed
+// by commit hash rather than path/mtime the way internal/scanner.FileCache
+// is: a commit's stats never change once computed, so unlike a file cache
+// there is no invalidation to get wrong, only work to avoid repeating on
+// unchanged history.
+type CommitStatsCache struct {
+	mu      sync.Mutex
+	entries map[string][]FileStat
+}
+
+// LoadCommitStatsCache reads dir/git-stats-cache.json, returning an empty
+// cache if it does not exist yet or fails to parse.
+func LoadCommitStatsCache(dir string) *CommitStatsCache {
+	c := &CommitStatsCache{entries: make(map[string][]FileStat)}
+
+	data, err := os.ReadFile(filepath.Join(dir, commitStatsCacheFileName))
+	if err != nil {
+		return c
+	}
+	if err := json.Unmarshal(data, &c.entries); err != nil {
+		c.entries = make(map[string][]FileStat)
+	}
+	return c
+}
+
+// Get returns the cached stats for a commit hash, if any.
+func (c *CommitStatsCache) Get(hash string) ([]FileStat, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats, ok := c.entries[hash]
+	return stats, ok
+}
+
+// Put records stats for a commit hash.
+func (c *CommitStatsCache) Put(hash string, stats []FileStat) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[hash] = stats
+}
+
+// Save writes the cache to dir/git-stats-cache.json, creating dir if needed.
+func (c *CommitStatsCache) Save(dir string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(c.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, commitStatsCacheFileName), data, 0o644)
+}
+
+// statsFor returns c's per-file stats, using cache when present and
+// populating it otherwise. cache may be nil, in which case every call
+// computes fresh stats.
+func statsFor(cache *CommitStatsCache, c *object.Commit) ([]FileStat, error) {
+	hash := c.Hash.String()
+
+	if cache != nil {
+		if stats, ok := cache.Get(hash); ok {
+			return stats, nil
+		}
+	}
+
+	fileStats, err := c.Stats()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]FileStat, len(fileStats))
+	for i, fs := range fileStats {
+		stats[i] = FileStat{Name: fs.Name, Addition: fs.Addition, Deletion: fs.Deletion}
+	}
+
+	if cache != nil {
+		cache.Put(hash, stats)
+	}
+	return stats, nil
+}
@@ -1,19 +1,42 @@
 package git
 
 import (
+	"container/heap"
+	"context"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/detective-cli/detective/pkg/models"
+	"github.com/detective-cli/detective/pkg/relnotes"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
-// AnalyzeRepository analyzes a git repository at the given path
-func AnalyzeRepository(rootPath string) (models.GitEvidence, error) {
+// sourceExtensions mirrors the code-file extension set internal/scanner
+// uses for markers and categorization; kept as its own copy since
+// internal/git has no reason to depend on internal/scanner.
+var sourceExtensions = map[string]bool{
+	".go": true, ".js": true, ".ts": true, ".py": true, ".java": true,
+	".c": true, ".cpp": true, ".h": true, ".rs": true, ".rb": true,
+	".php": true, ".cs": true, ".swift": true, ".kt": true,
+}
+
+// AnalyzeRepository analyzes a git repository at the given path. ctx is
+// checked between commits so a large history can be cancelled mid-walk;
+// go-git itself has no context-aware log iteration, so this is a
+// best-effort checkpoint rather than a hard interrupt.
+//
+// activityWindow bounds the churn and bus-factor analysis in
+// evidence.CodeActivity to the trailing window rather than full history;
+// statsCache, if non-nil, is consulted and populated with each visited
+// commit's diff stats so re-runs over unchanged history skip recomputing
+// them (a commit's stats are immutable once computed, so there's no
+// staleness to check the way scanner.FileCache checks mtime/size).
+func AnalyzeRepository(ctx context.Context, rootPath string, activityWindow time.Duration, statsCache *CommitStatsCache) (models.GitEvidence, error) {
 	evidence := models.GitEvidence{
 		IsRepository: false,
 	}
@@ -26,6 +49,7 @@ func AnalyzeRepository(rootPath string) (models.GitEvidence, error) {
 	}
 
 	evidence.IsRepository = true
+	evidence.RepoPath = rootPath
 
 	// Get commit history
 	ref, err := repo.Head()
@@ -46,8 +70,17 @@ func AnalyzeRepository(rootPath string) (models.GitEvidence, error) {
 	now := time.Now()
 	totalMessageLength := 0
 	goodMessages := 0
+	conventionalCommits := 0
+
+	authorActivity := make(map[string]*authorTotals)
+	fileChurn := make(map[string]*fileTotals)
+	activityWindowDays := activityWindow.Hours() / 24
 
 	err = commitIter.ForEach(func(c *object.Commit) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		commitCount++
 
 		// Track contributors
@@ -81,12 +114,42 @@ func AnalyzeRepository(rootPath string) (models.GitEvidence, error) {
 			commits90Days++
 		}
 
+		// Accumulate churn within activityWindow for evidence.CodeActivity.
+		if daysSince <= activityWindowDays {
+			stats, err := statsFor(statsCache, c)
+			if err != nil {
+				return err
+			}
+
+			totals, exists := authorActivity[c.Author.Email]
+			if !exists {
+				totals = &authorTotals{name: c.Author.Name}
+				authorActivity[c.Author.Email] = totals
+			}
+
+			for _, fs := range stats {
+				totals.added += fs.Addition
+				totals.removed += fs.Deletion
+
+				ft, exists := fileChurn[fs.Name]
+				if !exists {
+					ft = &fileTotals{}
+					fileChurn[fs.Name] = ft
+				}
+				ft.linesChanged += fs.Addition + fs.Deletion
+				ft.commits++
+			}
+		}
+
 		// Analyze commit message quality
 		msg := strings.TrimSpace(c.Message)
 		totalMessageLength += len(msg)
 		if len(msg) > 10 && !strings.HasPrefix(msg, "WIP") && !strings.HasPrefix(msg, "fix") {
 			goodMessages++
 		}
+		if relnotes.IsConventional(strings.SplitN(msg, "\n", 2)[0]) {
+			conventionalCommits++
+		}
 
 		// Keep recent commits (last 10)
 		if len(recentCommits) < 10 {
@@ -100,6 +163,9 @@ func AnalyzeRepository(rootPath string) (models.GitEvidence, error) {
 
 		return nil
 	})
+	if err != nil {
+		return evidence, err
+	}
 
 	evidence.TotalCommits = commitCount
 	evidence.Contributors = len(contributors)
@@ -118,30 +184,24 @@ func AnalyzeRepository(rootPath string) (models.GitEvidence, error) {
 	evidence.CommitFrequency.Last30Days = commits30Days
 	evidence.CommitFrequency.Last90Days = commits90Days
 
-	// Calculate commit message quality score
+	// Calculate commit message quality score, blending the legacy
+	// length/prefix heuristic with the fraction of commits that parse as
+	// a conventional-commit or emoji-prefixed message (see pkg/relnotes),
+	// a much stronger signal of a disciplined commit history.
 	if commitCount > 0 {
-		evidence.CommitMessageQuality = float64(goodMessages) / float64(commitCount)
+		legacyScore := float64(goodMessages) / float64(commitCount)
+		conventionalScore := float64(conventionalCommits) / float64(commitCount)
+		evidence.CommitMessageQuality = (legacyScore + conventionalScore) / 2
 	}
 
-	// Get top contributors
-	var contributorList []models.ContributorInfo
+	// Get top contributors, keeping only the top 5 by commit count via a
+	// bounded min-heap rather than sorting the full contributor list.
+	contributorTop := &contributorHeap{}
 	for _, c := range contributors {
 		c.Percent = float64(c.Commits) / float64(commitCount) * 100
-		contributorList = append(contributorList, *c)
-	}
-	// Sort by commits (simple bubble sort for top 5)
-	for i := 0; i < len(contributorList)-1 && i < 5; i++ {
-		for j := 0; j < len(contributorList)-i-1; j++ {
-			if contributorList[j].Commits < contributorList[j+1].Commits {
-				contributorList[j], contributorList[j+1] = contributorList[j+1], contributorList[j]
-			}
-		}
-	}
-	if len(contributorList) > 5 {
-		evidence.TopContributors = contributorList[:5]
-	} else {
-		evidence.TopContributors = contributorList
+		addTopContributor(contributorTop, *c, 5)
 	}
+	evidence.TopContributors = contributorTop.sortedDescending()
 
 	// Check for uncommitted changes
 	worktree, err := repo.Worktree()
@@ -163,12 +223,196 @@ func AnalyzeRepository(rootPath string) (models.GitEvidence, error) {
 		evidence.BranchCount = branchCount
 	}
 
+	headCommit, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		return evidence, nil
+	}
+	busFactor, err := directoryBusFactor(repo, headCommit)
+	if err != nil {
+		return evidence, err
+	}
+
+	evidence.CodeActivity = models.CodeActivity{
+		Window:          activityWindow,
+		AuthorActivity:  authorActivitySlice(authorActivity),
+		FileChurn:       fileChurnSlice(fileChurn),
+		DirectoryOwners: busFactor,
+	}
+
 	return evidence, nil
 }
 
+type authorTotals struct {
+	name           string
+	added, removed int
+}
+
+type fileTotals struct {
+	linesChanged, commits int
+}
+
+func authorActivitySlice(authorActivity map[string]*authorTotals) []models.AuthorActivity {
+	activity := make([]models.AuthorActivity, 0, len(authorActivity))
+	for email, totals := range authorActivity {
+		activity = append(activity, models.AuthorActivity{
+			Name:         totals.name,
+			Email:        email,
+			LinesAdded:   totals.added,
+			LinesRemoved: totals.removed,
+		})
+	}
+	sort.Slice(activity, func(i, j int) bool {
+		return activity[i].LinesAdded+activity[i].LinesRemoved > activity[j].LinesAdded+activity[j].LinesRemoved
+	})
+	return activity
+}
+
+func fileChurnSlice(fileChurn map[string]*fileTotals) []models.FileChurn {
+	churn := make([]models.FileChurn, 0, len(fileChurn))
+	for path, totals := range fileChurn {
+		churn = append(churn, models.FileChurn{
+			Path:         path,
+			LinesChanged: totals.linesChanged,
+			CommitCount:  totals.commits,
+		})
+	}
+	sort.Slice(churn, func(i, j int) bool { return churn[i].LinesChanged > churn[j].LinesChanged })
+	return churn
+}
+
+// directoryBusFactor blames every source file (per sourceExtensions) at
+// commit, aggregates line ownership by author email within each file's
+// directory, and reduces that to the minimum number of authors whose
+// combined share covers at least half a directory's current lines.
+func directoryBusFactor(repo *git.Repository, commit *object.Commit) ([]models.DirectoryBusFactor, error) {
+	files, err := commit.Files()
+	if err != nil {
+		return nil, err
+	}
+
+	dirOwnership := make(map[string]map[string]int) // directory -> author -> lines owned
+
+	err = files.ForEach(func(f *object.File) error {
+		if !sourceExtensions[filepath.Ext(f.Name)] {
+			return nil
+		}
+
+		blame, err := git.Blame(commit, f.Name)
+		if err != nil {
+			// Binary or otherwise unblameable files are skipped rather than
+			// failing the whole analysis.
+			return nil
+		}
+
+		dir := filepath.Dir(f.Name)
+		owners, ok := dirOwnership[dir]
+		if !ok {
+			owners = make(map[string]int)
+			dirOwnership[dir] = owners
+		}
+
+		for _, line := range blame.Lines {
+			owners[line.Author]++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dirs := make([]string, 0, len(dirOwnership))
+	for dir := range dirOwnership {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	result := make([]models.DirectoryBusFactor, 0, len(dirs))
+	for _, dir := range dirs {
+		owners := dirOwnership[dir]
+		total := 0
+		for _, lines := range owners {
+			total += lines
+		}
+		if total == 0 {
+			continue
+		}
+
+		type owned struct {
+			author string
+			lines  int
+		}
+		ranked := make([]owned, 0, len(owners))
+		for author, lines := range owners {
+			ranked = append(ranked, owned{author, lines})
+		}
+		sort.Slice(ranked, func(i, j int) bool { return ranked[i].lines > ranked[j].lines })
+
+		covered := 0
+		busFactor := 0
+		var topOwners []string
+		for _, o := range ranked {
+			covered += o.lines
+			busFactor++
+			topOwners = append(topOwners, o.author)
+			if float64(covered)/float64(total) >= 0.5 {
+				break
+			}
+		}
+
+		result = append(result, models.DirectoryBusFactor{
+			Directory: dir,
+			BusFactor: busFactor,
+			TopOwners: topOwners,
+		})
+	}
+
+	return result, nil
+}
+
 // IsGitRepository checks if a path is a git repository
 func IsGitRepository(path string) bool {
 	gitPath := filepath.Join(path, ".git")
 	info, err := os.Stat(gitPath)
 	return err == nil && info.IsDir()
 }
+
+// contributorHeap is a min-heap of models.ContributorInfo ordered by
+// Commits, letting addTopContributor keep the top N most active
+// contributors without sorting the full contributor list.
+type contributorHeap []models.ContributorInfo
+
+func (h contributorHeap) Len() int           { return len(h) }
+func (h contributorHeap) Less(i, j int) bool { return h[i].Commits < h[j].Commits }
+func (h contributorHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *contributorHeap) Push(x any) { *h = append(*h, x.(models.ContributorInfo)) }
+
+func (h *contributorHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// addTopContributor keeps h bounded to limit entries, the limit most
+// active contributors by Commits seen so far.
+func addTopContributor(h *contributorHeap, c models.ContributorInfo, limit int) {
+	if h.Len() < limit {
+		heap.Push(h, c)
+		return
+	}
+	if h.Len() > 0 && (*h)[0].Commits < c.Commits {
+		heap.Pop(h)
+		heap.Push(h, c)
+	}
+}
+
+// sortedDescending drains h into a slice ordered most-commits-first.
+func (h *contributorHeap) sortedDescending() []models.ContributorInfo {
+	result := make([]models.ContributorInfo, h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(h).(models.ContributorInfo)
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package inference
+
+import (
+	"sort"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// DiffFindings matches previous and current by their highest-priority
+// shared models.SignatureAlgorithm, classifying each as Added, Resolved,
+// Persisted, or Changed. Both slices are expected to have been through
+// SignFindings already; a finding with no signatures never matches
+// anything and is always Added or Resolved.
+//
+// Matching falls back from a finding's most specific signature to a
+// coarser one: if current's SignatureLocation isn't present on any
+// previous finding, its SignatureHash is tried next, and so on. If none of
+// a finding's algorithms are present on the other side at all, it's
+// unrelated to anything there.
+func DiffFindings(previous, current []models.Finding) models.FindingsDiff {
+	index := indexSignatures(previous)
+	matched := make([]bool, len(previous))
+
+	var diff models.FindingsDiff
+	for _, cur := range current {
+		i, ok := bestMatch(cur, index)
+		if !ok {
+			diff.Added = append(diff.Added, cur)
+			continue
+		}
+
+		matched[i] = true
+		prev := previous[i]
+		if prev.Severity == cur.Severity && prev.Description == cur.Description {
+			diff.Persisted = append(diff.Persisted, cur)
+		} else {
+			diff.Changed = append(diff.Changed, models.FindingChange{Previous: prev, Current: cur})
+		}
+	}
+
+	for i, prev := range previous {
+		if !matched[i] {
+			diff.Resolved = append(diff.Resolved, prev)
+		}
+	}
+
+	return diff
+}
+
+// indexSignatures builds, for each algorithm seen, a lookup from that
+// algorithm's signature value to the index of the findings slice it came
+// from.
+func indexSignatures(findings []models.Finding) map[models.SignatureAlgorithm]map[string]int {
+	index := map[models.SignatureAlgorithm]map[string]int{}
+	for i, f := range findings {
+		for _, sig := range f.Signatures {
+			byValue, ok := index[sig.Algorithm]
+			if !ok {
+				byValue = map[string]int{}
+				index[sig.Algorithm] = byValue
+			}
+			byValue[sig.Value] = i
+		}
+	}
+	return index
+}
+
+// bestMatch tries f's signatures against index in descending Priority
+// order, returning the first algorithm that matches on both sides.
+func bestMatch(f models.Finding, index map[models.SignatureAlgorithm]map[string]int) (int, bool) {
+	sigs := make([]models.FindingSignature, len(f.Signatures))
+	copy(sigs, f.Signatures)
+	sort.Slice(sigs, func(i, j int) bool { return sigs[i].Priority > sigs[j].Priority })
+
+	for _, sig := range sigs {
+		if byValue, ok := index[sig.Algorithm]; ok {
+			if i, ok := byValue[sig.Value]; ok {
+				return i, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,93 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestSignFindingsLocationSignatureWhenFilePathPresent(t *testing.T) {
+	findings := []models.Finding{
+		{ProbeID: "hardcodedSecrets", Category: models.FindingSecurity, Values: map[string]string{"filePath": "main.go", "line": "10"}},
+	}
+
+	signed := SignFindings(findings)
+	sigs := signed[0].Signatures
+	if len(sigs) != 2 {
+		t.Fatalf("got %d signatures, want 2 (location + hash)", len(sigs))
+	}
+	if sigs[0].Algorithm != models.SignatureLocation || sigs[0].Priority != 1 {
+		t.Errorf("sigs[0] = %+v, want SignatureLocation at Priority 1", sigs[0])
+	}
+	if sigs[1].Algorithm != models.SignatureHash || sigs[1].Priority != 0 {
+		t.Errorf("sigs[1] = %+v, want SignatureHash at Priority 0", sigs[1])
+	}
+}
+
+func TestSignFindingsHashOnlyWithoutFilePath(t *testing.T) {
+	findings := []models.Finding{{ProbeID: "noTestFiles", Category: models.FindingCodeQuality}}
+
+	sigs := SignFindings(findings)[0].Signatures
+	if len(sigs) != 1 || sigs[0].Algorithm != models.SignatureHash {
+		t.Fatalf("sigs = %+v, want a single SignatureHash", sigs)
+	}
+}
+
+func TestSignFindingsIsStableAcrossCalls(t *testing.T) {
+	f := models.Finding{ProbeID: "noTestFiles", Category: models.FindingCodeQuality, Evidence: []string{"0 test files"}}
+
+	a := SignFindings([]models.Finding{f})[0].Signatures
+	b := SignFindings([]models.Finding{f})[0].Signatures
+	if a[0].Value != b[0].Value {
+		t.Errorf("signature value changed across identical calls: %q vs %q", a[0].Value, b[0].Value)
+	}
+}
+
+func TestDiffFindingsClassifiesAllFourCategories(t *testing.T) {
+	resolved := models.Finding{ProbeID: "p1", Category: models.FindingCodeQuality, Evidence: []string{"resolved"}}
+	persisted := models.Finding{ProbeID: "p2", Category: models.FindingCodeQuality, Evidence: []string{"persisted"}}
+	changedPrev := models.Finding{ProbeID: "p3", Category: models.FindingSecurity, Severity: models.SeverityLow, Evidence: []string{"changed"}}
+	changedCurr := models.Finding{ProbeID: "p3", Category: models.FindingSecurity, Severity: models.SeverityCritical, Evidence: []string{"changed"}}
+	added := models.Finding{ProbeID: "p4", Category: models.FindingCodeQuality, Evidence: []string{"added"}}
+
+	previous := SignFindings([]models.Finding{resolved, persisted, changedPrev})
+	current := SignFindings([]models.Finding{persisted, changedCurr, added})
+
+	diff := DiffFindings(previous, current)
+
+	if len(diff.Added) != 1 || diff.Added[0].ProbeID != "p4" {
+		t.Errorf("Added = %+v, want just p4", diff.Added)
+	}
+	if len(diff.Resolved) != 1 || diff.Resolved[0].ProbeID != "p1" {
+		t.Errorf("Resolved = %+v, want just p1", diff.Resolved)
+	}
+	if len(diff.Persisted) != 1 || diff.Persisted[0].ProbeID != "p2" {
+		t.Errorf("Persisted = %+v, want just p2", diff.Persisted)
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0].Current.Severity != models.SeverityCritical {
+		t.Errorf("Changed = %+v, want p3 with Current.Severity critical", diff.Changed)
+	}
+}
+
+func TestDiffFindingsPrefersHigherPrioritySignature(t *testing.T) {
+	prev := models.Finding{ProbeID: "hardcodedSecrets", Category: models.FindingSecurity, Values: map[string]string{"filePath": "a.go", "line": "1"}, Evidence: []string{"old evidence"}}
+	curr := models.Finding{ProbeID: "hardcodedSecrets", Category: models.FindingSecurity, Values: map[string]string{"filePath": "a.go", "line": "1"}, Evidence: []string{"new evidence"}}
+
+	diff := DiffFindings(SignFindings([]models.Finding{prev}), SignFindings([]models.Finding{curr}))
+
+	if len(diff.Added) != 0 || len(diff.Resolved) != 0 {
+		t.Fatalf("expected the location signature to match despite differing evidence/hash signature, got Added=%+v Resolved=%+v", diff.Added, diff.Resolved)
+	}
+	if len(diff.Persisted) != 1 {
+		t.Errorf("Persisted = %+v, want 1 (same severity/description, matched via location)", diff.Persisted)
+	}
+}
+
+func TestDiffFindingsUnsignedFindingsNeverMatch(t *testing.T) {
+	f := models.Finding{ProbeID: "p1", Category: models.FindingCodeQuality}
+
+	diff := DiffFindings([]models.Finding{f}, []models.Finding{f})
+	if len(diff.Added) != 1 || len(diff.Resolved) != 1 {
+		t.Errorf("unsigned findings should never match each other; got Added=%+v Resolved=%+v", diff.Added, diff.Resolved)
+	}
+}
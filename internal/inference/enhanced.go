@@ -1,260 +1,142 @@
 package inference
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 
+	"golang.org/x/sync/errgroup"
+
+	"github.com/detective-cli/detective/pkg/inference/engine"
+	"github.com/detective-cli/detective/pkg/inference/probes"
 	"github.com/detective-cli/detective/pkg/models"
 )
 
-// GenerateFindingsEnhanced analyzes evidence and produces comprehensive findings with actionable recommendations
-func GenerateFindingsEnhanced(evidence models.Evidence) []models.Finding {
-	var findings []models.Finding
-
-	// Analyze file system with smart categorization
-	findings = append(findings, analyzeFileSystemEnhanced(evidence.FileSystem)...)
-
-	// Analyze git with enhanced metrics
-	findings = append(findings, analyzeGitEnhanced(evidence.Git)...)
+// predicateEngine evaluates every built-in probe whose def.yml carries a
+// CEL Predicate (see pkg/inference/engine): the git and filesystem probes
+// below have all migrated to it, replacing what used to be hand-written
+// if/else ladders in analyzeGitEnhanced and analyzeFileSystemEnhanced.
+var predicateEngine = mustPredicateEngine()
 
-	// Analyze code markers with recommendations
-	findings = append(findings, analyzeCodeMarkersEnhanced(evidence.CodeMarkers)...)
-
-	// Analyze security evidence
-	findings = append(findings, analyzeSecurityEvidence(evidence.Security)...)
-
-	// Project-specific findings
-	findings = append(findings, analyzeProjectType(evidence.ProjectType)...)
-
-	return findings
-}
-
-func analyzeFileSystemEnhanced(fs models.FileSystemEvidence) []models.Finding {
-	var findings []models.Finding
-
-	// Check for empty or very small projects
-	if fs.TotalFiles < 5 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityLow,
-			Title:       "Minimal File Count",
-			Description: fmt.Sprintf("Project contains only %d files, suggesting early development stage.", fs.TotalFiles),
-			Evidence:    []string{fmt.Sprintf("Total files: %d", fs.TotalFiles)},
-			Category:    models.FindingCodeQuality,
-			Recommendations: []string{
-				"This is normal for new projects",
-				"Consider adding a README.md to document your project",
-				"Add .gitignore to exclude unnecessary files",
-			},
-		})
+func mustPredicateEngine() *engine.Engine {
+	eng, err := engine.New(probes.All())
+	if err != nil {
+		panic(fmt.Sprintf("inference: compiling built-in probe predicates: %v", err))
 	}
+	return eng
+}
 
-	// Smart large file analysis based on category
-	sourceCodeIssues := 0
-	buildArtifactIssues := 0
-	for _, file := range fs.LargestFiles {
-		if file.Size > 1024*1024 { // > 1MB
-			switch file.Category {
-			case models.CategorySource:
-				sourceCodeIssues++
-			case models.CategoryBuildArtifact:
-				buildArtifactIssues++
-			case models.CategoryAsset:
-				// Expected for assets, skip
-				continue
+// GenerateFindingsEnhanced analyzes evidence and produces comprehensive
+// findings with actionable recommendations. The analyze* passes are
+// independent of one another, so they run concurrently through an errgroup
+// worker pool bounded by jobs (0 or negative defaults to runtime.NumCPU()):
+// on a large tree the security pass (secret/SQLi/XSS pattern matching)
+// otherwise dominates while the predicate, code marker, code activity, and
+// code smell passes sit idle. Results are merged by sorting on
+// (Category, Severity, Title, Evidence[0]) rather than pass-completion
+// order, so ComputeReportHash stays stable across runs regardless of
+// scheduling.
+func GenerateFindingsEnhanced(ctx context.Context, evidence models.Evidence, jobs int) ([]models.Finding, error) {
+	if jobs <= 0 {
+		jobs = runtime.NumCPU()
+	}
+
+	passes := []func(context.Context) ([]models.Finding, error){
+		func(ctx context.Context) ([]models.Finding, error) { return analyzeViaPredicates(ctx, evidence) },
+		func(ctx context.Context) ([]models.Finding, error) {
+			return analyzeCodeMarkersEnhanced(ctx, evidence.CodeMarkers)
+		},
+		func(ctx context.Context) ([]models.Finding, error) {
+			return analyzeSecurityEvidence(ctx, evidence.Security)
+		},
+		func(ctx context.Context) ([]models.Finding, error) {
+			return analyzeProjectType(ctx, evidence.ProjectType)
+		},
+		func(ctx context.Context) ([]models.Finding, error) {
+			return analyzeCodeActivity(ctx, evidence.Git.CodeActivity)
+		},
+		func(ctx context.Context) ([]models.Finding, error) {
+			return analyzeCodeSmells(ctx, evidence.CodeSmells)
+		},
+	}
+
+	results := make([][]models.Finding, len(passes))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(jobs)
+	for i, pass := range passes {
+		i, pass := i, pass
+		g.Go(func() error {
+			result, err := pass(gctx)
+			if err != nil {
+				return err
 			}
-		}
-	}
-
-	if sourceCodeIssues > 0 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityMedium,
-			Title:       "Large Source Code Files",
-			Description: fmt.Sprintf("Found %d source code files exceeding 1MB. Large files are harder to review and maintain.", sourceCodeIssues),
-			Evidence:    []string{fmt.Sprintf("%d large source files detected", sourceCodeIssues)},
-			Category:    models.FindingCodeQuality,
-			Recommendations: []string{
-				"Consider breaking large files into smaller, focused modules",
-				"Extract reusable components into separate files",
-				"Review for potential code duplication",
-			},
+			results[i] = result
+			return nil
 		})
 	}
-
-	if buildArtifactIssues > 0 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityLow,
-			Title:       "Build Artifacts in Repository",
-			Description: fmt.Sprintf("Found %d build artifacts (compiled binaries, .exe, .dll). These should typically be excluded from version control.", buildArtifactIssues),
-			Evidence:    []string{fmt.Sprintf("%d build artifacts found", buildArtifactIssues)},
-			Category:    models.FindingMaintainability,
-			Recommendations: []string{
-				"Add build artifacts to .gitignore",
-				"Remove existing artifacts with: git rm --cached <file>",
-				"Use CI/CD for artifact generation instead",
-			},
-		})
+	if err := g.Wait(); err != nil {
+		return nil, err
 	}
 
-	// Check for documentation
-	if fs.CategorizedFiles.DocumentationFiles == 0 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityLow,
-			Title:       "Missing Documentation",
-			Description: "No documentation files (README.md, etc.) detected. Good documentation is essential for project maintainability.",
-			Evidence:    []string{"No .md or .txt files found"},
-			Category:    models.FindingMaintainability,
-			Recommendations: []string{
-				"Create a README.md with project overview, setup instructions, and usage examples",
-				"Add CONTRIBUTING.md for collaboration guidelines",
-				"Consider API documentation if building a library",
-			},
-		})
-	}
-
-	// Check for tests
-	if fs.CategorizedFiles.TestFiles == 0 && fs.CategorizedFiles.SourceFiles > 10 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityMedium,
-			Title:       "No Test Files Detected",
-			Description: "Project has source code but no test files. Tests are crucial for code quality and maintainability.",
-			Evidence:    []string{fmt.Sprintf("%d source files, 0 test files", fs.CategorizedFiles.SourceFiles)},
-			Category:    models.FindingCodeQuality,
-			Recommendations: []string{
-				"Start with testing critical business logic",
-				"Aim for at least 70% code coverage on core functionality",
-				"Set up automated testing in CI/CD pipeline",
-				"Use framework-specific testing tools (Jest, pytest, go test, etc.)",
-			},
-		})
+	var findings []models.Finding
+	for _, result := range results {
+		findings = append(findings, result...)
 	}
+	sortFindings(findings)
 
-	return findings
+	return findings, nil
 }
 
-func analyzeGitEnhanced(git models.GitEvidence) []models.Finding {
-	var findings []models.Finding
-
-	// Check if not a git repository
-	if !git.IsRepository {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityHigh,
-			Title:       "No Version Control",
-			Description: "Directory is not a git repository. Version control is essential for tracking changes, collaboration, and rollback capability.",
-			Evidence:    []string{"No .git directory found"},
-			Category:    models.FindingVersionControl,
-			Recommendations: []string{
-				"Initialize git: git init",
-				"Create .gitignore file for your language/framework",
-				"Make initial commit: git add . && git commit -m 'Initial commit'",
-				"Consider pushing to GitHub/GitLab for backup and collaboration",
-			},
-		})
-		return findings
-	}
-
-	// Check for uncommitted changes
-	if git.UncommittedChanges {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityLow,
-			Title:       "Uncommitted Changes Detected",
-			Description: "Working directory has uncommitted changes. Regular commits help track progress and enable easy rollback.",
-			Evidence:    []string{"Git status shows modified files"},
-			Category:    models.FindingVersionControl,
-			Recommendations: []string{
-				"Review changes with: git status",
-				"Commit meaningful chunks: git add <files> && git commit -m 'descriptive message'",
-				"Push to remote regularly to back up work",
-			},
-		})
-	}
-
-	// Analyze commit frequency
-	if git.CommitFrequency.Last30Days == 0 && git.TotalCommits > 10 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityMedium,
-			Title:       "Inactive Repository",
-			Description: "No commits in the last 30 days. Regular commits indicate active development and maintenance.",
-			Evidence:    []string{fmt.Sprintf("Last commit: %s", git.LastCommitDate.Format("2006-01-02"))},
-			Category:    models.FindingVersionControl,
-			Recommendations: []string{
-				"If project is complete, add documentation noting stable/production status",
-				"If inactive, consider archiving the repository",
-				"For active projects, commit at least weekly to track progress",
-			},
-		})
-	} else if git.CommitFrequency.Last7Days > 20 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityLow,
-			Title:       "High Commit Frequency",
-			Description: fmt.Sprintf("%d commits in the last 7 days. Very frequent commits may indicate work-in-progress or lack of local testing before committing.", git.CommitFrequency.Last7Days),
-			Evidence:    []string{fmt.Sprintf("Average %.1f commits/week", git.CommitFrequency.AveragePerWeek)},
-			Category:    models.FindingVersionControl,
-			Recommendations: []string{
-				"Ensure commits are meaningful and tested before pushing",
-				"Consider using feature branches for experimental work",
-				"Use 'git commit --amend' to fix recent commits instead of making new ones",
-			},
-		})
-	}
+// sortFindings orders findings by (Category, Severity, Title, Evidence[0])
+// so the merged result of concurrently-run passes is deterministic
+// regardless of which pass finished first.
+func sortFindings(findings []models.Finding) {
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.Category != b.Category {
+			return a.Category < b.Category
+		}
+		if a.Severity != b.Severity {
+			return a.Severity > b.Severity
+		}
+		if a.Title != b.Title {
+			return a.Title < b.Title
+		}
+		return firstEvidence(a) < firstEvidence(b)
+	})
+}
 
-	// Analyze commit message quality
-	if git.CommitMessageQuality < 0.5 && git.TotalCommits > 10 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityLow,
-			Title:       "Low Commit Message Quality",
-			Description: fmt.Sprintf("%.0f%% of commit messages are unclear or too brief. Good commit messages help track project history.", git.CommitMessageQuality*100),
-			Evidence:    []string{"Many commits with messages like 'fix', 'WIP', 'update'"},
-			Category:    models.FindingMaintainability,
-			Recommendations: []string{
-				"Use format: 'type(scope): description' (e.g., 'feat(auth): add login validation')",
-				"Describe WHAT changed and WHY, not HOW",
-				"Keep first line under 50 chars, add details in body if needed",
-				"Set up commit message templates or conventional commits",
-			},
-		})
+func firstEvidence(f models.Finding) string {
+	if len(f.Evidence) == 0 {
+		return ""
 	}
+	return f.Evidence[0]
+}
 
-	// Check contributor diversity
-	if git.Contributors == 1 && git.TotalCommits > 50 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityLow,
-			Title:       "Single Contributor (Bus Factor Risk)",
-			Description: "Project has only one contributor despite substantial work. This creates knowledge concentration risk.",
-			Evidence:    []string{fmt.Sprintf("%d commits by 1 person", git.TotalCommits)},
-			Category:    models.FindingMaintainability,
-			Recommendations: []string{
-				"Document key architecture decisions and design patterns",
-				"Consider pair programming or code review practices",
-				"Invite collaborators if it's an open-source project",
-				"Write comprehensive README and contribution guidelines",
-			},
-		})
+// analyzeViaPredicates runs predicateEngine against the full evidence
+// snapshot, covering every filesystem and git probe now defined by a CEL
+// Predicate instead of hand-written Go (see pkg/inference/engine).
+func analyzeViaPredicates(ctx context.Context, evidence models.Evidence) ([]models.Finding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
+	return predicateEngine.Evaluate(evidence)
+}
 
-	// Low commit history
-	if git.TotalCommits < 10 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityLow,
-			Title:       "Limited Commit History",
-			Description: "Repository has minimal commit history, suggesting early development stage.",
-			Evidence:    []string{fmt.Sprintf("Total commits: %d", git.TotalCommits)},
-			Category:    models.FindingVersionControl,
-			Recommendations: []string{
-				"This is normal for new projects",
-				"Commit frequently to capture incremental progress",
-				"Each commit should be a logical, working state",
-			},
-		})
+func analyzeCodeMarkersEnhanced(ctx context.Context, markers []models.CodeMarker) ([]models.Finding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
-	return findings
-}
-
-func analyzeCodeMarkersEnhanced(markers []models.CodeMarker) []models.Finding {
 	var findings []models.Finding
 
 	if len(markers) == 0 {
-		return findings
+		return findings, nil
 	}
 
 	markerCounts := make(map[string]int)
@@ -262,171 +144,236 @@ func analyzeCodeMarkersEnhanced(markers []models.CodeMarker) []models.Finding {
 		markerCounts[marker.Type]++
 	}
 
-	// FIXME and BUG are higher severity
+	// FIXME and BUG are higher severity; the probe's own YAML pegs severity
+	// at medium, so escalate to high here before overwriting Severity.
 	if count := markerCounts["FIXME"] + markerCounts["BUG"]; count > 0 {
-		severity := models.SeverityMedium
+		finding, err := probes.Render("knownIssues",
+			map[string]string{"Count": strconv.Itoa(count), "TopN": strconv.Itoa(min(count, 10))},
+			[]string{fmt.Sprintf("FIXME: %d, BUG: %d", markerCounts["FIXME"], markerCounts["BUG"])})
+		if err != nil {
+			return nil, err
+		}
 		if count > 10 {
-			severity = models.SeverityHigh
+			finding.Severity = models.SeverityHigh
 		}
-		findings = append(findings, models.Finding{
-			Severity:    severity,
-			Title:       "Known Issues in Code",
-			Description: fmt.Sprintf("Found %d FIXME/BUG markers indicating known problems requiring attention.", count),
-			Evidence:    []string{fmt.Sprintf("FIXME: %d, BUG: %d", markerCounts["FIXME"], markerCounts["BUG"])},
-			Category:    models.FindingCodeQuality,
-			Recommendations: []string{
-				fmt.Sprintf("Create GitHub issues for top %d critical markers", min(count, 10)),
-				"Prioritize fixing bugs before adding new features",
-				"Set up automated TODO tracking with tools like todo-tree",
-				"Schedule regular 'technical debt' sprints to address markers",
-			},
-		})
+		findings = append(findings, finding)
 	}
 
 	// TODO markers
 	if count := markerCounts["TODO"]; count > 20 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityMedium,
-			Title:       "High TODO Count",
-			Description: fmt.Sprintf("Found %d TODO markers. Many pending tasks may indicate incomplete features or ambitious roadmap.", count),
-			Evidence:    []string{fmt.Sprintf("TODO markers: %d", count)},
-			Category:    models.FindingMaintainability,
-			Recommendations: []string{
-				fmt.Sprintf("Review and convert %d high-priority TODOs into tracked issues", min(count, 20)),
-				"Remove obsolete TODOs that are no longer relevant",
-				"Link TODOs to specific issue numbers: // TODO(#123): description",
-				"Set deadlines for feature completion",
-			},
-		})
+		finding, err := probes.Render("highTodoCount",
+			map[string]string{"Count": strconv.Itoa(count), "TopN": strconv.Itoa(min(count, 20))},
+			[]string{fmt.Sprintf("TODO markers: %d", count)})
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, finding)
 	} else if count > 0 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityLow,
-			Title:       "Pending Tasks",
-			Description: fmt.Sprintf("Found %d TODO markers indicating planned work.", count),
-			Evidence:    []string{fmt.Sprintf("TODO markers: %d", count)},
-			Category:    models.FindingMaintainability,
-			Recommendations: []string{
-				"This is normal for active projects",
-				"Ensure TODOs have clear descriptions and owners",
-			},
-		})
+		finding, err := probes.Render("pendingTasks",
+			map[string]string{"Count": strconv.Itoa(count)},
+			[]string{fmt.Sprintf("TODO markers: %d", count)})
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, finding)
 	}
 
 	// HACK markers
 	if count := markerCounts["HACK"]; count > 0 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityMedium,
-			Title:       "Technical Debt Indicators",
-			Description: fmt.Sprintf("Found %d HACK markers suggesting suboptimal solutions requiring refactoring.", count),
-			Evidence:    []string{fmt.Sprintf("HACK markers: %d", count)},
-			Category:    models.FindingCodeQuality,
-			Recommendations: []string{
-				"Schedule refactoring sessions to eliminate hacks",
-				"Document why the hack exists and ideal solution",
-				"Prioritize removing hacks in critical paths",
-				"Consider pair programming to find better solutions",
+		finding, err := probes.Render("technicalDebt",
+			map[string]string{"Count": strconv.Itoa(count)},
+			[]string{fmt.Sprintf("HACK markers: %d", count)})
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, finding)
+	}
+
+	return findings, nil
+}
+
+// hotspotFileLimit caps how many of the highest-churn files are checked
+// against their directory's bus factor, since bus factor only matters for
+// the files actually driving that directory's risk.
+const hotspotFileLimit = 5
+
+// churnRatioHighSeverity and churnRatioCriticalSeverity escalate a hotspot
+// finding's severity past the probe's default medium as its lines-changed
+// per owning author grows; these are heuristic thresholds, not derived
+// from any external standard.
+const (
+	churnRatioHighSeverity     = 300
+	churnRatioCriticalSeverity = 1000
+)
+
+// analyzeCodeActivity flags "hotspot" files: among the highest-churn files
+// in activity.FileChurn (already sorted descending by internal/git), any
+// whose directory has a bus factor of 1 combine high change frequency with
+// single-person ownership, the combination the codeChurnHotspot probe
+// warns about.
+func analyzeCodeActivity(ctx context.Context, activity models.CodeActivity) ([]models.Finding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	busFactorByDir := make(map[string]int, len(activity.DirectoryOwners))
+	for _, d := range activity.DirectoryOwners {
+		busFactorByDir[d.Directory] = d.BusFactor
+	}
+
+	files := activity.FileChurn
+	if len(files) > hotspotFileLimit {
+		files = files[:hotspotFileLimit]
+	}
+
+	var findings []models.Finding
+	for _, f := range files {
+		busFactor, ok := busFactorByDir[filepath.Dir(f.Path)]
+		if !ok || busFactor > 1 {
+			continue
+		}
+
+		finding, err := probes.Render("codeChurnHotspot",
+			map[string]string{
+				"Path":         f.Path,
+				"LinesChanged": strconv.Itoa(f.LinesChanged),
+				"CommitCount":  strconv.Itoa(f.CommitCount),
+				"BusFactor":    strconv.Itoa(busFactor),
 			},
-		})
+			[]string{fmt.Sprintf("%s: %d lines changed across %d commits, bus factor %d", f.Path, f.LinesChanged, f.CommitCount, busFactor)})
+		if err != nil {
+			return nil, err
+		}
+
+		ratio := float64(f.LinesChanged) / float64(busFactor)
+		if ratio > churnRatioCriticalSeverity {
+			finding.Severity = models.SeverityCritical
+		} else if ratio > churnRatioHighSeverity {
+			finding.Severity = models.SeverityHigh
+		}
+		findings = append(findings, finding)
+	}
+
+	return findings, nil
+}
+
+// codeSmellProbes maps each models.CodeSmellType to the probe that renders
+// its finding.
+var codeSmellProbes = map[models.CodeSmellType]string{
+	models.SmellHighComplexity:      "highCognitiveComplexity",
+	models.SmellDuplicatedBranches:  "duplicatedBranches",
+	models.SmellIdenticalConditions: "identicalConditions",
+	models.SmellOneIterationLoop:    "oneIterationLoop",
+}
+
+// analyzeCodeSmells renders one Finding per structural issue
+// scanner.ScanCodeSmells reported, file:line anchored via Evidence[0].
+func analyzeCodeSmells(ctx context.Context, smells []models.CodeSmell) ([]models.Finding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var findings []models.Finding
+	for _, smell := range smells {
+		probeID, ok := codeSmellProbes[smell.Type]
+		if !ok {
+			continue
+		}
+
+		finding, err := probes.Render(probeID,
+			map[string]string{
+				"Function":   smell.Function,
+				"Complexity": strconv.Itoa(smell.Complexity),
+			},
+			[]string{fmt.Sprintf("%s:%d", smell.File, smell.Line)})
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, finding)
 	}
 
-	return findings
+	return findings, nil
 }
 
-func analyzeSecurityEvidence(security models.SecurityEvidence) []models.Finding {
+func analyzeSecurityEvidence(ctx context.Context, security models.SecurityEvidence) ([]models.Finding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	var findings []models.Finding
 
 	// Hardcoded secrets
 	if len(security.HardcodedSecrets) > 0 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityCritical,
-			Title:       "Hardcoded Secrets Detected",
-			Description: fmt.Sprintf("Found %d potential hardcoded secrets (API keys, passwords, tokens). This is a critical security risk.", len(security.HardcodedSecrets)),
-			Evidence:    buildSecretEvidence(security.HardcodedSecrets),
-			Category:    models.FindingSecurity,
-			Recommendations: []string{
-				"IMMEDIATE: Remove secrets from code and rotate compromised credentials",
-				"Use environment variables or secret management tools (Vault, AWS Secrets Manager)",
-				"Add .env to .gitignore and provide .env.example template",
-				"Use git-secrets or pre-commit hooks to prevent future leaks",
-				"Review git history and purge secrets if already committed",
-			},
-		})
+		finding, err := probes.Render("hardcodedSecrets",
+			map[string]string{"Count": strconv.Itoa(len(security.HardcodedSecrets))},
+			buildSecretEvidence(security.HardcodedSecrets))
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, finding)
 	}
 
 	// SQL injection risks
 	if len(security.SQLInjectionRisks) > 0 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityHigh,
-			Title:       "SQL Injection Vulnerability Patterns",
-			Description: fmt.Sprintf("Found %d potential SQL injection vulnerabilities from string concatenation in queries.", len(security.SQLInjectionRisks)),
-			Evidence:    buildSecurityRiskEvidence(security.SQLInjectionRisks),
-			Category:    models.FindingSecurity,
-			Recommendations: []string{
-				"CRITICAL: Use parameterized queries or prepared statements",
-				"Never concatenate user input directly into SQL",
-				"Use ORM frameworks (Eloquent, Sequelize, SQLAlchemy) with built-in protection",
-				"Validate and sanitize all user inputs",
-				"Run automated security scans with tools like SQLMap",
-			},
-		})
+		finding, err := probes.Render("sqlInjection",
+			map[string]string{"Count": strconv.Itoa(len(security.SQLInjectionRisks))},
+			buildSecurityRiskEvidence(security.SQLInjectionRisks))
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, finding)
 	}
 
 	// XSS risks
 	if len(security.XSSRisks) > 0 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityMedium,
-			Title:       "Cross-Site Scripting (XSS) Risks",
-			Description: fmt.Sprintf("Found %d potential XSS vulnerabilities from unsafe HTML rendering.", len(security.XSSRisks)),
-			Evidence:    buildSecurityRiskEvidence(security.XSSRisks),
-			Category:    models.FindingSecurity,
-			Recommendations: []string{
-				"Escape all user-generated content before rendering",
-				"Use framework built-in escaping (React auto-escapes, use {{ }} in templates)",
-				"Implement Content Security Policy (CSP) headers",
-				"Avoid innerHTML, document.write, and eval() with user data",
-				"Use DOMPurify for sanitizing rich text",
-			},
-		})
+		finding, err := probes.Render("xss",
+			map[string]string{"Count": strconv.Itoa(len(security.XSSRisks))},
+			buildSecurityRiskEvidence(security.XSSRisks))
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, finding)
 	}
 
 	// Other insecure patterns
 	if len(security.InsecurePatterns) > 0 {
-		findings = append(findings, models.Finding{
-			Severity:    models.SeverityMedium,
-			Title:       "Insecure Coding Patterns",
-			Description: fmt.Sprintf("Found %d security concerns (weak crypto, insecure protocols, etc.).", len(security.InsecurePatterns)),
-			Evidence:    buildSecurityRiskEvidence(security.InsecurePatterns),
-			Category:    models.FindingSecurity,
-			Recommendations: []string{
-				"Replace MD5/SHA1 with bcrypt, argon2, or PBKDF2 for passwords",
-				"Use HTTPS instead of HTTP for all external communication",
-				"Keep dependencies updated to patch known vulnerabilities",
-				"Enable security linting in your IDE",
-			},
-		})
+		finding, err := probes.Render("insecurePatterns",
+			map[string]string{"Count": strconv.Itoa(len(security.InsecurePatterns))},
+			buildSecurityRiskEvidence(security.InsecurePatterns))
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, finding)
 	}
 
-	return findings
+	return findings, nil
 }
 
-func analyzeProjectType(projectType models.ProjectType) []models.Finding {
+func analyzeProjectType(ctx context.Context, projectType models.ProjectType) ([]models.Finding, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	var findings []models.Finding
 
 	if projectType.Framework == "" {
-		return findings
+		return findings, nil
 	}
 
-	// Generic project type detection finding
-	findings = append(findings, models.Finding{
-		Severity:        models.SeverityLow,
-		Title:           fmt.Sprintf("%s Project Detected", projectType.Framework),
-		Description:     fmt.Sprintf("Detected %s project. Framework-specific best practices will be applied.", projectType.Framework),
-		Evidence:        projectType.DetectedFiles,
-		Category:        models.FindingCodeQuality,
-		Recommendations: getFrameworkRecommendations(projectType.Framework),
-	})
+	// Generic project type detection finding. The probe's own
+	// recommendations are generic placeholders; the framework-specific
+	// list below is Go logic rather than YAML because it varies by an open
+	// set of framework names, not a fixed template.
+	finding, err := probes.Render("projectTypeDetected",
+		map[string]string{"Framework": projectType.Framework}, projectType.DetectedFiles)
+	if err != nil {
+		return nil, err
+	}
+	finding.Title = fmt.Sprintf("%s Project Detected", projectType.Framework)
+	finding.Recommendations = getFrameworkRecommendations(projectType.Framework)
+	findings = append(findings, finding)
 
-	return findings
+	return findings, nil
 }
 
 func getFrameworkRecommendations(framework string) []string {
@@ -503,8 +450,16 @@ func min(a, b int) int {
 	return b
 }
 
-// CalculateHealthScoreWeighted computes health score with weighted categories
-func CalculateHealthScoreWeighted(findings []models.Finding, evidence models.Evidence) (int, models.HealthBreakdown) {
+// CalculateHealthScoreWeighted computes health score with weighted
+// categories. A finding skipped entirely, costing no points, if either
+// applies: it's flagged (via ApplySuppressions) as a false positive or
+// accepted risk, or it's been triaged (via ApplyTriage) as dismissed or
+// resolved. Either way it still appears in the report.
+func CalculateHealthScoreWeighted(ctx context.Context, findings []models.Finding, evidence models.Evidence) (int, models.HealthBreakdown, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, models.HealthBreakdown{}, err
+	}
+
 	breakdown := models.HealthBreakdown{
 		VersionControl: 20,
 		CodeQuality:    25,
@@ -516,6 +471,10 @@ func CalculateHealthScoreWeighted(findings []models.Finding, evidence models.Evi
 
 	// Deduct points by category
 	for _, finding := range findings {
+		if IsSuppressedFromScoring(finding) || finding.State == models.StateDismissed || finding.State == models.StateResolved {
+			continue
+		}
+
 		deduction := 0
 		switch finding.Severity {
 		case models.SeverityCritical:
@@ -556,7 +515,7 @@ func CalculateHealthScoreWeighted(findings []models.Finding, evidence models.Evi
 	totalScore := breakdown.VersionControl + breakdown.CodeQuality + breakdown.Security +
 		breakdown.Performance + breakdown.Documentation + breakdown.Testing
 
-	return totalScore, breakdown
+	return totalScore, breakdown, nil
 }
 
 func max(a, b int) int {
@@ -0,0 +1,43 @@
+package inference
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+const lastScanFileName = "last-scan.json"
+
+// LoadLastScan reads dir/last-scan.json, the signed findings from the
+// previous detective run against this target. It returns nil, nil if the
+// file does not exist yet (first run) or fails to parse, so callers can
+// treat a missing or corrupt last-scan file the same as "nothing to diff
+// against" rather than failing the whole scan.
+func LoadLastScan(dir string) []models.Finding {
+	data, err := os.ReadFile(filepath.Join(dir, lastScanFileName))
+	if err != nil {
+		return nil
+	}
+
+	var findings []models.Finding
+	if err := json.Unmarshal(data, &findings); err != nil {
+		return nil
+	}
+	return findings
+}
+
+// SaveLastScan writes the signed findings of the current run to
+// dir/last-scan.json, creating dir if needed, so the next run can diff
+// against it.
+func SaveLastScan(dir string, findings []models.Finding) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(findings, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, lastScanFileName), data, 0o644)
+}
@@ -0,0 +1,144 @@
+package inference
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// statusPriority orders models.ReportStatus for merging: a still-Running
+// shard means the merged view is incomplete, an Error anywhere means it's
+// unreliable, and only Success everywhere yields an overall Success.
+var statusPriority = map[models.ReportStatus]int{
+	models.ReportStatusRunning: 2,
+	models.ReportStatusError:   1,
+	models.ReportStatusSuccess: 0,
+}
+
+// MergeReports combines reports from multiple scan runs (e.g. one per
+// subdirectory, one per scanner pass, or one per CI shard on a different
+// commit) into a single report, in the spirit of Harbor's
+// NativeReportSummary merge: findings are deduplicated by a stable key
+// instead of concatenated, conflicting fields are resolved by an explicit
+// priority ladder rather than last-write-wins, and the result records
+// which input reports it was built from.
+//
+// The merged report's TargetPath, Context, and Evidence are taken from the
+// first input; only Findings, Status, InvestigatorNotes, HealthBreakdown/
+// HealthScore, and the hash-related fields are actually merged across all
+// inputs.
+func MergeReports(reports ...models.Report) (models.Report, error) {
+	if len(reports) == 0 {
+		return models.Report{}, fmt.Errorf("merging reports: no reports given")
+	}
+	if len(reports) == 1 {
+		return reports[0], nil
+	}
+
+	merged := reports[0]
+	merged.Findings = mergeFindings(reports)
+	merged.Status = mergeStatus(reports)
+	merged.Evidence.InvestigatorNotes = mergeNotes(reports)
+
+	breakdown, err := CalculateHealthBreakdown(context.Background(), merged.Evidence, merged.Findings)
+	if err != nil {
+		return models.Report{}, fmt.Errorf("re-scoring merged findings: %w", err)
+	}
+	merged.HealthBreakdown = breakdown
+	merged.HealthScore = breakdown.VersionControl + breakdown.CodeQuality + breakdown.Security + breakdown.Performance + breakdown.Documentation + breakdown.Testing
+
+	merged.SourceHashes = sourceHashes(reports)
+	merged.ReportHash = ComputeReportHash(merged)
+
+	return merged, nil
+}
+
+// findingKey is the stable dedup key for a finding across reports. It's a
+// stand-in for the probe-ID-based signature planned once probes carry
+// stable IDs on their findings (see the finding-signature work tracked
+// alongside this); until then, category + title + the file/line a probe
+// recorded in Values is the closest approximation available.
+func findingKey(f models.Finding) string {
+	return strings.Join([]string{
+		string(f.Category),
+		f.Title,
+		f.Values["filePath"],
+		f.Values["line"],
+	}, "|")
+}
+
+// mergeFindings deduplicates findings by findingKey. When the same finding
+// appears in more than one input, the surviving copy takes the maximum
+// severity across all occurrences (Critical > High > Medium > Low) rather
+// than whichever occurrence was seen last.
+func mergeFindings(reports []models.Report) []models.Finding {
+	order := []string{}
+	byKey := map[string]models.Finding{}
+
+	for _, r := range reports {
+		for _, f := range r.Findings {
+			key := findingKey(f)
+			existing, ok := byKey[key]
+			if !ok {
+				order = append(order, key)
+				byKey[key] = f
+				continue
+			}
+			if f.Severity > existing.Severity {
+				existing.Severity = f.Severity
+			}
+			byKey[key] = existing
+		}
+	}
+
+	merged := make([]models.Finding, 0, len(order))
+	for _, key := range order {
+		merged = append(merged, byKey[key])
+	}
+	return merged
+}
+
+// mergeStatus resolves the overall status across inputs using
+// statusPriority: Running beats Error beats Success.
+func mergeStatus(reports []models.Report) models.ReportStatus {
+	result := models.ReportStatusSuccess
+	for _, r := range reports {
+		if statusPriority[r.Status] > statusPriority[result] {
+			result = r.Status
+		}
+	}
+	return result
+}
+
+// mergeNotes unions InvestigatorNotes across inputs, suppressing exact
+// duplicates while preserving first-seen order.
+func mergeNotes(reports []models.Report) []string {
+	seen := map[string]bool{}
+	var notes []string
+	for _, r := range reports {
+		for _, n := range r.Evidence.InvestigatorNotes {
+			if seen[n] {
+				continue
+			}
+			seen[n] = true
+			notes = append(notes, n)
+		}
+	}
+	return notes
+}
+
+// sourceHashes returns the sorted, non-empty ReportHash of every input, so
+// the merged report's own hash can incorporate them.
+func sourceHashes(reports []models.Report) []string {
+	var hashes []string
+	for _, r := range reports {
+		if r.ReportHash != "" {
+			hashes = append(hashes, r.ReportHash)
+		}
+	}
+	sort.Strings(hashes)
+	return hashes
+}
@@ -0,0 +1,187 @@
+package probes
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/detective-cli/detective/pkg/models"
+	"gopkg.in/yaml.v3"
+)
+
+// probeDoc is the YAML shape of a custom probe dropped into --probes-dir.
+// Custom probes can't carry Go logic, so they're restricted to a simple
+// metric/operator/threshold comparison against a fixed set of evidence
+// metrics (see metricValue) — enough for organization-specific thresholds
+// without needing a full expression language.
+type probeDoc struct {
+	ID             string `yaml:"id"`
+	Short          string `yaml:"short"`
+	Motivation     string `yaml:"motivation"`
+	Implementation string `yaml:"implementation"`
+	Remediation    struct {
+		Effort string   `yaml:"effort"`
+		Steps  []string `yaml:"steps"`
+	} `yaml:"remediation"`
+	Metric      string  `yaml:"metric"`   // e.g. "git.totalCommits"
+	Operator    string  `yaml:"operator"` // lt|lte|gt|gte|eq
+	Threshold   float64 `yaml:"threshold"`
+	Severity    string  `yaml:"severity"` // low|medium|high|critical
+	Category    string  `yaml:"category"` // code-quality|security|performance|maintainability|version-control|documentation
+	Title       string  `yaml:"title"`
+	Description string  `yaml:"description"` // may reference %v for the metric's value
+}
+
+// LoadDir reads every *.yml/*.yaml file in dir as a declarative probe
+// definition and registers it on the engine.
+func (e *Engine) LoadDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("reading probes dir %s: %w", dir, err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		if ext != ".yml" && ext != ".yaml" {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading probe %s: %w", path, err)
+		}
+
+		var doc probeDoc
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("parsing probe %s: %w", path, err)
+		}
+
+		probe, err := declarativeProbe(doc)
+		if err != nil {
+			return fmt.Errorf("probe %s: %w", path, err)
+		}
+		e.Register(probe)
+	}
+
+	return nil
+}
+
+func declarativeProbe(doc probeDoc) (Probe, error) {
+	if doc.ID == "" {
+		return Probe{}, fmt.Errorf("missing id")
+	}
+	compare, err := compareFunc(doc.Operator)
+	if err != nil {
+		return Probe{}, err
+	}
+
+	return Probe{
+		ID:         doc.ID,
+		Short:      doc.Short,
+		Motivation: doc.Motivation,
+		Remediation: Remediation{
+			Effort: doc.Remediation.Effort,
+			Steps:  doc.Remediation.Steps,
+		},
+		run: func(e models.Evidence) ([]models.Finding, error) {
+			value, ok := metricValue(e, doc.Metric)
+			if !ok || !compare(value, doc.Threshold) {
+				return nil, nil
+			}
+			return []models.Finding{{
+				Severity:        ruleSeverity(doc.Severity),
+				Title:           doc.Title,
+				Description:     fmt.Sprintf(doc.Description, value),
+				Evidence:        []string{fmt.Sprintf("%s = %v", doc.Metric, value)},
+				Recommendations: doc.Remediation.Steps,
+				Category:        models.FindingCategory(doc.Category),
+				Values:          map[string]string{doc.Metric: strconv.FormatFloat(value, 'f', -1, 64)},
+			}}, nil
+		},
+	}, nil
+}
+
+func compareFunc(operator string) (func(value, threshold float64) bool, error) {
+	switch operator {
+	case "lt":
+		return func(v, t float64) bool { return v < t }, nil
+	case "lte":
+		return func(v, t float64) bool { return v <= t }, nil
+	case "gt":
+		return func(v, t float64) bool { return v > t }, nil
+	case "gte":
+		return func(v, t float64) bool { return v >= t }, nil
+	case "eq":
+		return func(v, t float64) bool { return v == t }, nil
+	default:
+		return nil, fmt.Errorf("unsupported operator %q (want lt, lte, gt, gte, or eq)", operator)
+	}
+}
+
+// metricValue resolves a dotted metric name to a numeric evidence value.
+// This is deliberately a small, fixed allowlist rather than general
+// reflection, so custom probes cannot accidentally (or intentionally)
+// reach into unrelated evidence internals.
+func metricValue(e models.Evidence, metric string) (float64, bool) {
+	switch metric {
+	case "git.totalCommits":
+		return float64(e.Git.TotalCommits), true
+	case "git.contributors":
+		return float64(e.Git.Contributors), true
+	case "git.daysSinceLastCommit":
+		if e.Git.LastCommitDate.IsZero() {
+			return 0, false
+		}
+		return time.Since(e.Git.LastCommitDate).Hours() / 24, true
+	case "git.commitMessageQuality":
+		return e.Git.CommitMessageQuality, true
+	case "filesystem.totalFiles":
+		return float64(e.FileSystem.TotalFiles), true
+	case "filesystem.totalDirectories":
+		return float64(e.FileSystem.TotalDirectories), true
+	case "codeMarkers.todoCount":
+		return float64(countMarkerType(e.CodeMarkers, "TODO")), true
+	case "codeMarkers.fixmeCount":
+		return float64(countMarkerType(e.CodeMarkers, "FIXME")), true
+	case "codeMarkers.hackCount":
+		return float64(countMarkerType(e.CodeMarkers, "HACK")), true
+	case "security.hardcodedSecretCount":
+		return float64(len(e.Security.HardcodedSecrets)), true
+	case "security.sqlInjectionRiskCount":
+		return float64(len(e.Security.SQLInjectionRisks)), true
+	case "security.xssRiskCount":
+		return float64(len(e.Security.XSSRisks)), true
+	default:
+		return 0, false
+	}
+}
+
+// countMarkerType counts markers of a given type, e.g. "TODO" or "FIXME".
+func countMarkerType(markers []models.CodeMarker, markerType string) int {
+	count := 0
+	for _, m := range markers {
+		if m.Type == markerType {
+			count++
+		}
+	}
+	return count
+}
+
+func ruleSeverity(s string) models.Severity {
+	switch s {
+	case "critical":
+		return models.SeverityCritical
+	case "high":
+		return models.SeverityHigh
+	case "medium":
+		return models.SeverityMedium
+	default:
+		return models.SeverityLow
+	}
+}
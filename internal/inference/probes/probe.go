@@ -0,0 +1,138 @@
+// Package probes loads and evaluates organization-specific findings checks
+// from --probes-dir YAML files, modeled after OpenSSF Scorecard's
+// finding/probe design: each check is a small, independently named unit
+// ("probe") that inspects evidence and emits zero or more models.Finding.
+// detective's own built-in checks are a CEL-predicate probe set evaluated
+// by pkg/inference/engine instead; this package only covers the
+// org-specific probes layered on top via --probes-dir.
+package probes
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// probeTimeout bounds how long Run waits on a single probe before
+// abandoning it and moving on. Probes only inspect an already-gathered
+// Evidence snapshot in memory, so one that runs past this is almost
+// certainly buggy rather than legitimately slow.
+const probeTimeout = 10 * time.Second
+
+// Remediation describes how to address a probe's finding and how much work
+// that is expected to take.
+type Remediation struct {
+	Effort string // "Low" or "High"
+	Steps  []string
+}
+
+// Probe is a single named check, loaded from a --probes-dir YAML file and
+// evaluated generically against a fixed set of metrics (see declarative.go).
+type Probe struct {
+	ID             string
+	Short          string
+	Motivation     string
+	Implementation string
+	Remediation    Remediation
+
+	run func(models.Evidence) ([]models.Finding, error)
+}
+
+// Engine holds every probe that will run against a given Evidence snapshot,
+// loaded from a --probes-dir; the zero value is an empty engine ready for
+// LoadDir.
+type Engine struct {
+	probes []Probe
+}
+
+// Register adds a probe to the engine, for callers assembling a custom set.
+func (e *Engine) Register(p Probe) {
+	e.probes = append(e.probes, p)
+}
+
+// Probes returns the probes currently loaded, for inspection/testing.
+func (e *Engine) Probes() []Probe {
+	return e.probes
+}
+
+// Run evaluates every loaded probe against evidence, dispatching them into
+// an errgroup worker pool bounded by jobs (0 or negative defaults to
+// runtime.NumCPU()) so a large probe set doesn't run entirely on the
+// calling goroutine. Each probe additionally gets its own probeTimeout
+// derived from ctx, so one hung probe is abandoned rather than blocking the
+// rest of the run. A single failing or timed-out probe does not abort the
+// run; its error is wrapped and returned alongside whatever other probes
+// produced, and results are returned in registration order regardless of
+// completion order, so callers see deterministic output.
+func (e *Engine) Run(ctx context.Context, evidence models.Evidence, jobs int) ([]models.Finding, error) {
+	if jobs <= 0 {
+		jobs = runtime.NumCPU()
+	}
+
+	results := make([][]models.Finding, len(e.probes))
+	errs := make([]error, len(e.probes))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(jobs)
+
+	for i, p := range e.probes {
+		i, p := i, p
+		g.Go(func() error {
+			probeCtx, cancel := context.WithTimeout(gctx, probeTimeout)
+			defer cancel()
+
+			type outcome struct {
+				findings []models.Finding
+				err      error
+			}
+			done := make(chan outcome, 1)
+			go func() {
+				findings, err := p.run(evidence)
+				done <- outcome{findings, err}
+			}()
+
+			select {
+			case o := <-done:
+				if o.err != nil {
+					errs[i] = fmt.Errorf("probe %s: %w", p.ID, o.err)
+					return nil
+				}
+				results[i] = tagProbeResults(p.ID, o.findings)
+			case <-probeCtx.Done():
+				errs[i] = fmt.Errorf("probe %s: %w", p.ID, probeCtx.Err())
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	var findings []models.Finding
+	var firstErr error
+	for i := range e.probes {
+		findings = append(findings, results[i]...)
+		if errs[i] != nil && firstErr == nil {
+			firstErr = errs[i]
+		}
+	}
+
+	return findings, firstErr
+}
+
+// tagProbeResults stamps every finding a probe returned with its
+// ProbeID and Outcome, rather than making each probe body set them: a
+// probe's run only ever returns findings for conditions it actually
+// flagged (an empty/nil slice means it passed silently), so every result
+// here is unconditionally a Fail, the same convention probes.Render uses
+// for the YAML-defined probe set.
+func tagProbeResults(probeID string, findings []models.Finding) []models.Finding {
+	for i := range findings {
+		findings[i].ProbeID = probeID
+		findings[i].Outcome = models.OutcomeFail
+	}
+	return findings
+}
@@ -0,0 +1,55 @@
+package inference
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// SignFindings computes stable models.FindingSignature values for every
+// finding so a later run of detective against the same target can
+// recognize "this is the same finding" even though each run allocates a
+// fresh models.Finding. Call it once the final finding list for a scan is
+// assembled (after GenerateFindingsEnhanced and any custom probes), since
+// signatures are derived from each finding's own fields rather than
+// threaded through from evidence.
+func SignFindings(findings []models.Finding) []models.Finding {
+	signed := make([]models.Finding, len(findings))
+	for i, f := range findings {
+		f.Signatures = signatures(f)
+		signed[i] = f
+	}
+	return signed
+}
+
+// signatures computes every algorithm that applies to f, most specific
+// first. SignatureLocation only applies when the finding's probe recorded
+// a file/line in Values (mainly security findings); SignatureHash applies
+// to every finding.
+func signatures(f models.Finding) []models.FindingSignature {
+	var sigs []models.FindingSignature
+
+	if file, line := f.Values["filePath"], f.Values["line"]; file != "" && line != "" {
+		sigs = append(sigs, models.FindingSignature{
+			Algorithm: models.SignatureLocation,
+			Value:     digest(f.ProbeID, file, line),
+			Priority:  1,
+		})
+	}
+
+	sigs = append(sigs, models.FindingSignature{
+		Algorithm: models.SignatureHash,
+		Value:     digest(f.ProbeID, string(f.Category), strings.Join(f.Evidence, "|")),
+		Priority:  0,
+	})
+
+	return sigs
+}
+
+// digest hashes parts into a short, stable signature value.
+func digest(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "|")))
+	return hex.EncodeToString(sum[:])[:16]
+}
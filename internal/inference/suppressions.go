@@ -0,0 +1,113 @@
+package inference
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/detective-cli/detective/pkg/models"
+	"gopkg.in/yaml.v3"
+)
+
+const suppressionsFileName = "suppressions.yml"
+
+// Suppression is one entry from .detective/suppressions.yml: a finding
+// signature (see models.FindingSignature) mapped to the disposition a user
+// gave it with `detective suppress`.
+type Suppression struct {
+	Signature string                 `yaml:"signature"`
+	Type      models.FindingFlagType `yaml:"type"`
+	Reason    string                 `yaml:"reason"`
+	Author    string                 `yaml:"author,omitempty"`
+	CreatedAt time.Time              `yaml:"createdAt"`
+}
+
+// suppressionsFile is the on-disk shape of suppressions.yml.
+type suppressionsFile struct {
+	Suppressions []Suppression `yaml:"suppressions"`
+}
+
+// LoadSuppressions reads dir/suppressions.yml, returning nil if it does
+// not exist yet; a target with no suppressions configured is the common
+// case, not an error.
+func LoadSuppressions(dir string) ([]Suppression, error) {
+	data, err := os.ReadFile(filepath.Join(dir, suppressionsFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var file suppressionsFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", suppressionsFileName, err)
+	}
+	return file.Suppressions, nil
+}
+
+// SaveSuppressions writes suppressions to dir/suppressions.yml, creating
+// dir if needed.
+func SaveSuppressions(dir string, suppressions []Suppression) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := yaml.Marshal(suppressionsFile{Suppressions: suppressions})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, suppressionsFileName), data, 0o644)
+}
+
+// ApplySuppressions attaches a models.FindingFlag to every finding that has
+// a signature matching a suppressions entry. A finding matches if any of
+// its Signatures (any algorithm, any priority) equals a suppression's
+// Signature; this is deliberately looser than DiffFindings' priority-
+// ordered matching, since a suppression should stick even if the finding's
+// more specific signature (e.g. SignatureLocation) shifts because the
+// offending line moved.
+func ApplySuppressions(findings []models.Finding, suppressions []Suppression) []models.Finding {
+	if len(suppressions) == 0 {
+		return findings
+	}
+
+	bySignature := make(map[string]Suppression, len(suppressions))
+	for _, s := range suppressions {
+		bySignature[s.Signature] = s
+	}
+
+	applied := make([]models.Finding, len(findings))
+	for i, f := range findings {
+		for _, sig := range f.Signatures {
+			s, ok := bySignature[sig.Value]
+			if !ok {
+				continue
+			}
+			f.Flags = append(f.Flags, models.FindingFlag{
+				Type:      s.Type,
+				Reason:    s.Reason,
+				Author:    s.Author,
+				CreatedAt: s.CreatedAt,
+			})
+			break
+		}
+		applied[i] = f
+	}
+	return applied
+}
+
+// IsSuppressedFromScoring reports whether f carries a flag that should
+// exclude it from health-score deductions: FlagFalsePositive (it isn't a
+// real issue), FlagAcceptedRisk (it is, but the user has knowingly accepted
+// it), FlagPolicyIgnored, or FlagWaived (see internal/policy). FlagWontFix
+// does not suppress scoring, since the issue is still real and outstanding.
+func IsSuppressedFromScoring(f models.Finding) bool {
+	for _, flag := range f.Flags {
+		switch flag.Type {
+		case models.FlagFalsePositive, models.FlagAcceptedRisk, models.FlagPolicyIgnored, models.FlagWaived:
+			return true
+		}
+	}
+	return false
+}
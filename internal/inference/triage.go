@@ -0,0 +1,119 @@
+package inference
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+const triageFileName = "triage.json"
+
+// TriageRecord is the persisted lifecycle state for one finding signature,
+// as recorded by `detective triage`.
+type TriageRecord struct {
+	State   models.FindingState      `json:"state"`
+	History []models.StateTransition `json:"history"`
+}
+
+// LoadTriage reads dir/triage.json, returning an empty map if it does not
+// exist yet or fails to parse: a target with no triage history yet is the
+// common case, not an error.
+func LoadTriage(dir string) map[string]TriageRecord {
+	data, err := os.ReadFile(filepath.Join(dir, triageFileName))
+	if err != nil {
+		return map[string]TriageRecord{}
+	}
+
+	records := map[string]TriageRecord{}
+	if err := json.Unmarshal(data, &records); err != nil {
+		return map[string]TriageRecord{}
+	}
+	return records
+}
+
+// SaveTriage writes records to dir/triage.json, creating dir if needed.
+func SaveTriage(dir string, records map[string]TriageRecord) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, triageFileName), data, 0o644)
+}
+
+// ApplyTriage merges stored triage state into freshly-generated findings,
+// matching on any of a finding's Signatures against records. A finding
+// with no matching record is StateOpen with no history, same as a finding
+// seen for the first time. This is what lets a previously dismissed
+// finding come back as dismissed even though the probe that produces it
+// still fires on every scan.
+func ApplyTriage(findings []models.Finding, records map[string]TriageRecord) []models.Finding {
+	applied := make([]models.Finding, len(findings))
+	for i, f := range findings {
+		f.State = models.StateOpen
+		f.StateHistory = nil
+
+		for _, sig := range f.Signatures {
+			if rec, ok := records[sig.Value]; ok {
+				f.State = rec.State
+				f.StateHistory = rec.History
+				break
+			}
+		}
+		applied[i] = f
+	}
+	return applied
+}
+
+// SetTriageState transitions signature to newState in records (creating
+// records if nil), appending a models.StateTransition, and returns the
+// updated map. The transition's From is StateOpen when signature has no
+// prior record.
+func SetTriageState(records map[string]TriageRecord, signature string, newState models.FindingState, reason string) map[string]TriageRecord {
+	if records == nil {
+		records = map[string]TriageRecord{}
+	}
+
+	from := models.StateOpen
+	prev, ok := records[signature]
+	if ok {
+		from = prev.State
+	}
+
+	history := append(prev.History, models.StateTransition{
+		From:   from,
+		To:     newState,
+		At:     time.Now(),
+		Reason: reason,
+	})
+
+	records[signature] = TriageRecord{State: newState, History: history}
+	return records
+}
+
+// FilterByState returns the subset of findings whose State is in states.
+// An empty states filters nothing, since "no states requested" reads as
+// "no filter" rather than "exclude everything".
+func FilterByState(findings []models.Finding, states []models.FindingState) []models.Finding {
+	if len(states) == 0 {
+		return findings
+	}
+
+	allowed := make(map[models.FindingState]bool, len(states))
+	for _, s := range states {
+		allowed[s] = true
+	}
+
+	var filtered []models.Finding
+	for _, f := range findings {
+		if allowed[f.State] {
+			filtered = append(filtered, f)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,87 @@
+package pipeline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// CacheEntry records enough about a previously scanned file to recognize
+// whether it still needs rescanning, plus the findings from the last scan
+// that produced it.
+type CacheEntry struct {
+	ModTime  time.Time               `json:"mod_time"`
+	Size     int64                   `json:"size"`
+	SHA256   string                  `json:"sha256"`
+	Evidence models.SecurityEvidence `json:"evidence"`
+}
+
+// matchesStat reports whether modTime/size match the entry closely enough
+// to trust its cached evidence without rehashing the file.
+func (e CacheEntry) matchesStat(modTime time.Time, size int64) bool {
+	return e.ModTime.Equal(modTime) && e.Size == size
+}
+
+// Cache is a persistent, JSON-backed record of per-file scan results keyed
+// by path, letting Run skip rescanning files that have not changed since
+// the last scan.
+type Cache struct {
+	Entries map[string]CacheEntry `json:"entries"`
+}
+
+const cacheFileName = "cache.json"
+
+// loadCache reads dir/cache.json, returning an empty Cache if it does not
+// exist yet or fails to parse.
+func loadCache(dir string) *Cache {
+	empty := func() *Cache { return &Cache{Entries: make(map[string]CacheEntry)} }
+
+	data, err := os.ReadFile(filepath.Join(dir, cacheFileName))
+	if err != nil {
+		return empty()
+	}
+
+	cache := empty()
+	if err := json.Unmarshal(data, cache); err != nil {
+		return empty()
+	}
+	if cache.Entries == nil {
+		cache.Entries = make(map[string]CacheEntry)
+	}
+	return cache
+}
+
+// save writes the cache to dir/cache.json, creating dir if needed.
+func (c *Cache) save(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, cacheFileName), data, 0o644)
+}
+
+// fileDigest hashes path's contents with SHA-256, used as the final
+// confirmation that a file matching on mtime+size has genuinely not
+// changed.
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
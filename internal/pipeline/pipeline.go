@@ -0,0 +1,227 @@
+// Package pipeline runs the security scan concurrently over a worker pool
+// and caches per-file results so repeated scans of a largely-unchanged tree
+// stay fast.
+package pipeline
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/detective-cli/detective/internal/security"
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// ScanOptions controls the concurrent, cache-aware scan performed by Run.
+type ScanOptions struct {
+	ExcludeDirs []string                  // directory names to skip entirely
+	Workers     int                       // worker pool size; defaults to runtime.NumCPU()
+	CacheDir    string                    // where cache.json lives; defaults to <rootPath>/.detective
+	NoCache     bool                      // bypass the cache entirely: always rescan, never persist
+	Since       string                    // git ref; when set, only files it reports as changed are forced to rescan
+	Config      security.SecretScanConfig // entropy/allowlist configuration passed through to security.ScanFile
+}
+
+// sourceExtensions mirrors the extension set security.ScanSecurityWithConfig
+// walks, so Run's cached results stay consistent with a plain scan.
+var sourceExtensions = map[string]bool{
+	".go": true, ".js": true, ".ts": true, ".py": true, ".php": true,
+	".java": true, ".rb": true, ".cs": true, ".jsx": true, ".tsx": true,
+	".vue": true, ".html": true, ".env": true, ".config": true, ".yml": true,
+}
+
+// Run walks rootPath with a worker pool sized by opts.Workers (default
+// runtime.NumCPU()), scanning each source file with security.ScanFile in
+// parallel. Results are reconciled against a persistent, content-hash-keyed
+// cache at opts.CacheDir/cache.json, so a file whose mtime, size, and
+// SHA-256 are unchanged since the last run is served from cache instead of
+// rescanned. When opts.Since names a git ref, only the files that ref
+// reports as changed are forced to rescan regardless of cache state; every
+// other file is served from cache whenever an entry exists for it.
+func Run(rootPath string, opts ScanOptions) (models.SecurityEvidence, error) {
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+	cacheDir := opts.CacheDir
+	if cacheDir == "" {
+		cacheDir = filepath.Join(rootPath, ".detective")
+	}
+
+	var changed map[string]bool
+	if opts.Since != "" {
+		var err error
+		changed, err = changedFilesSince(rootPath, opts.Since)
+		if err != nil {
+			return models.SecurityEvidence{}, err
+		}
+	}
+
+	cache := loadCache(cacheDir)
+	if opts.NoCache {
+		cache = &Cache{Entries: make(map[string]CacheEntry)}
+	}
+
+	paths, err := collectFiles(rootPath, opts.ExcludeDirs)
+	if err != nil {
+		return models.SecurityEvidence{}, err
+	}
+
+	type fileResult struct {
+		rel      string
+		evidence models.SecurityEvidence
+		entry    CacheEntry
+	}
+
+	jobs := make(chan string)
+	results := make(chan fileResult)
+
+	var wg sync.WaitGroup
+	for i := 0; i < opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range jobs {
+				rel, relErr := filepath.Rel(rootPath, path)
+				if relErr != nil {
+					rel = path
+				}
+
+				evidence, entry, ok := scanOrReuse(path, rel, opts.Config, cache, changed)
+				if !ok {
+					continue
+				}
+				results <- fileResult{rel: rel, evidence: evidence, entry: entry}
+			}
+		}()
+	}
+
+	go func() {
+		for _, path := range paths {
+			jobs <- path
+		}
+		close(jobs)
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	merged := models.SecurityEvidence{
+		HardcodedSecrets:  []models.SecretFinding{},
+		SQLInjectionRisks: []models.SecurityRisk{},
+		XSSRisks:          []models.SecurityRisk{},
+		InsecurePatterns:  []models.SecurityRisk{},
+	}
+	newCache := &Cache{Entries: make(map[string]CacheEntry)}
+	for res := range results {
+		security.MergeEvidence(&merged, res.evidence)
+		newCache.Entries[res.rel] = res.entry
+	}
+
+	if !opts.NoCache {
+		if err := newCache.save(cacheDir); err != nil {
+			return merged, err
+		}
+	}
+
+	return merged, nil
+}
+
+// scanOrReuse decides whether path can be served from cache or must be
+// scanned fresh, and returns the resulting evidence plus the CacheEntry to
+// persist for it. ok is false only when the file could not be read at all.
+func scanOrReuse(path, rel string, cfg security.SecretScanConfig, cache *Cache, changed map[string]bool) (models.SecurityEvidence, CacheEntry, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return models.SecurityEvidence{}, CacheEntry{}, false
+	}
+
+	forceRescan := changed != nil && changed[rel]
+
+	if !forceRescan {
+		if entry, ok := cache.Entries[rel]; ok && entry.matchesStat(info.ModTime(), info.Size()) {
+			if digest, err := fileDigest(path); err == nil && digest == entry.SHA256 {
+				return entry.Evidence, entry, true
+			}
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return models.SecurityEvidence{}, CacheEntry{}, false
+	}
+	defer file.Close()
+
+	evidence := security.ScanFile(path, file, cfg)
+
+	digest, err := fileDigest(path)
+	if err != nil {
+		return evidence, CacheEntry{}, true
+	}
+
+	entry := CacheEntry{
+		ModTime:  info.ModTime(),
+		Size:     info.Size(),
+		SHA256:   digest,
+		Evidence: evidence,
+	}
+	return evidence, entry, true
+}
+
+// collectFiles walks rootPath exactly as security.ScanSecurityWithConfig
+// does, returning the list of source/config files a scan would visit.
+func collectFiles(rootPath string, excludeDirs []string) ([]string, error) {
+	var paths []string
+
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		if info.IsDir() {
+			for _, exclude := range excludeDirs {
+				if info.Name() == exclude || strings.HasPrefix(info.Name(), ".") {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		ext := filepath.Ext(path)
+		if !sourceExtensions[ext] && !strings.HasSuffix(path, ".env") {
+			return nil
+		}
+
+		paths = append(paths, path)
+		return nil
+	})
+
+	return paths, err
+}
+
+// changedFilesSince shells out to `git diff --name-only <ref>` in rootPath
+// and returns the changed paths (relative to rootPath, slash-separated) as
+// a set.
+func changedFilesSince(rootPath, ref string) (map[string]bool, error) {
+	cmd := exec.Command("git", "diff", "--name-only", ref)
+	cmd.Dir = rootPath
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make(map[string]bool)
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		changed[filepath.FromSlash(line)] = true
+	}
+	return changed, nil
+}
@@ -0,0 +1,238 @@
+// Package policy reads .detective.yaml and decides, from a target's
+// findings, whether a scan should fail - the way OpenSSF Scorecard's own
+// policy files gate on probe evaluations rather than a hard-coded
+// threshold. A Policy declares a default fail_on severity comparison,
+// per-probe count overrides, path-based ignores, and expiring waivers; see
+// Evaluate for how they combine.
+package policy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/detective-cli/detective/pkg/models"
+	"gopkg.in/yaml.v3"
+)
+
+const fileName = ".detective.yaml"
+
+// Policy is the on-disk shape of .detective.yaml.
+type Policy struct {
+	FailOn  string                   `yaml:"fail_on"`
+	Probes  map[string]ProbeOverride `yaml:"probes"`
+	Ignore  []string                 `yaml:"ignore"`
+	Waivers []Waiver                 `yaml:"waivers"`
+}
+
+// ProbeOverride replaces the default FailOn comparison for one probe ID
+// with a count-based budget: that probe's findings only fail the policy
+// once there are more than Max of them, regardless of severity.
+type ProbeOverride struct {
+	Max int `yaml:"max"`
+}
+
+// Waiver exempts one finding, identified by the stable signature value
+// inference.SignFindings computed for it (the same identity
+// .detective/suppressions.yml's Signature field uses), from policy
+// evaluation until Until.
+type Waiver struct {
+	FindingID string    `yaml:"finding_id"`
+	Until     time.Time `yaml:"until"`
+	Reason    string    `yaml:"reason"`
+}
+
+// Decision is the result of evaluating a Policy against a set of findings.
+type Decision struct {
+	Findings   []models.Finding // all findings, with Flags annotated for ignored/waived entries
+	Violations []models.Finding // the subset that failed the policy
+	Fail       bool
+}
+
+// LoadPolicy reads targetDir/.detective.yaml, returning nil, nil if it does
+// not exist; a target with no policy configured evaluates nothing, rather
+// than being an error.
+func LoadPolicy(targetDir string) (*Policy, error) {
+	data, err := os.ReadFile(filepath.Join(targetDir, fileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var p Policy
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", fileName, err)
+	}
+	return &p, nil
+}
+
+// failOnPattern matches a fail_on expression like "severity>=high".
+var failOnPattern = regexp.MustCompile(`^\s*severity\s*(>=|<=|==|>|<)\s*(\w+)\s*$`)
+
+// parseFailOn parses expr into a comparator that reports whether a
+// finding's severity should fail the policy. An empty expr never fails
+// anything, so a Policy that only sets Ignore/Waivers/Probes is valid.
+func parseFailOn(expr string) (func(models.Severity) bool, error) {
+	if strings.TrimSpace(expr) == "" {
+		return func(models.Severity) bool { return false }, nil
+	}
+
+	m := failOnPattern.FindStringSubmatch(expr)
+	if m == nil {
+		return nil, fmt.Errorf("invalid fail_on expression %q (want e.g. \"severity>=high\")", expr)
+	}
+
+	threshold, err := parseSeverityName(m[2])
+	if err != nil {
+		return nil, err
+	}
+
+	op := m[1]
+	return func(sev models.Severity) bool {
+		switch op {
+		case ">=":
+			return sev >= threshold
+		case "<=":
+			return sev <= threshold
+		case ">":
+			return sev > threshold
+		case "<":
+			return sev < threshold
+		default: // "=="
+			return sev == threshold
+		}
+	}, nil
+}
+
+func parseSeverityName(s string) (models.Severity, error) {
+	switch strings.ToLower(s) {
+	case "low":
+		return models.SeverityLow, nil
+	case "medium":
+		return models.SeverityMedium, nil
+	case "high":
+		return models.SeverityHigh, nil
+	case "critical":
+		return models.SeverityCritical, nil
+	default:
+		return 0, fmt.Errorf("unknown severity %q in fail_on expression", s)
+	}
+}
+
+// findingPath is a best-effort file path for a finding, for matching
+// against Ignore globs: probes that populate Values["filePath"] (see
+// pkg/inference/probes) use that directly; everything else falls back to
+// the "file:line - description" shape internal/inference's security
+// evidence builders format into Evidence, the same convention
+// pkg/report/sarif relies on.
+var findingLocation = regexp.MustCompile(`^(.+):(\d+) - `)
+
+func findingPath(f models.Finding) (string, bool) {
+	if path, ok := f.Values["filePath"]; ok && path != "" {
+		return path, true
+	}
+	for _, e := range f.Evidence {
+		if m := findingLocation.FindStringSubmatch(e); m != nil {
+			return m[1], true
+		}
+	}
+	return "", false
+}
+
+// Evaluate classifies findings against p: first path-ignored and waived
+// findings are flagged and excluded, then the remainder is tested either by
+// its probe's ProbeOverride (a count budget) or, lacking one, by fail_on.
+// now is passed in rather than read via time.Now() so evaluation is
+// deterministic and testable.
+func (p *Policy) Evaluate(findings []models.Finding, now time.Time) (Decision, error) {
+	failOn, err := parseFailOn(p.FailOn)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	waiversByID := make(map[string]Waiver, len(p.Waivers))
+	for _, w := range p.Waivers {
+		waiversByID[w.FindingID] = w
+	}
+
+	annotated := make([]models.Finding, len(findings))
+	eligible := make([]models.Finding, 0, len(findings))
+	byProbe := map[string][]models.Finding{}
+
+	for i, f := range findings {
+		if path, ok := findingPath(f); ok && p.matchesIgnore(path) {
+			f.Flags = append(f.Flags, models.FindingFlag{
+				Type:      models.FlagPolicyIgnored,
+				Reason:    fmt.Sprintf("path matched a policy ignore pattern: %s", path),
+				CreatedAt: now,
+			})
+			annotated[i] = f
+			continue
+		}
+
+		if waiver, ok := p.matchingWaiver(f, waiversByID); ok && now.Before(waiver.Until) {
+			f.Flags = append(f.Flags, models.FindingFlag{
+				Type:      models.FlagWaived,
+				Reason:    waiver.Reason,
+				CreatedAt: now,
+			})
+			annotated[i] = f
+			continue
+		}
+
+		annotated[i] = f
+		eligible = append(eligible, f)
+		if _, overridden := p.Probes[f.ProbeID]; overridden {
+			byProbe[f.ProbeID] = append(byProbe[f.ProbeID], f)
+		}
+	}
+
+	var violations []models.Finding
+	for _, f := range eligible {
+		if _, overridden := p.Probes[f.ProbeID]; overridden {
+			continue // judged per-probe below, not per-finding
+		}
+		if failOn(f.Severity) {
+			violations = append(violations, f)
+		}
+	}
+	for probeID, probeFindings := range byProbe {
+		if len(probeFindings) > p.Probes[probeID].Max {
+			violations = append(violations, probeFindings...)
+		}
+	}
+
+	return Decision{
+		Findings:   annotated,
+		Violations: violations,
+		Fail:       len(violations) > 0,
+	}, nil
+}
+
+func (p *Policy) matchesIgnore(path string) bool {
+	path = filepath.ToSlash(path)
+	for _, pattern := range p.Ignore {
+		if ok, err := doublestar.Match(pattern, path); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchingWaiver finds the waiver whose finding_id matches one of f's
+// stable signatures, the same loose any-algorithm matching
+// inference.ApplySuppressions uses for suppressions.yml.
+func (p *Policy) matchingWaiver(f models.Finding, waiversByID map[string]Waiver) (Waiver, bool) {
+	for _, sig := range f.Signatures {
+		if w, ok := waiversByID[sig.Value]; ok {
+			return w, true
+		}
+	}
+	return Waiver{}, false
+}
@@ -0,0 +1,224 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestParseFailOnEmptyNeverFails(t *testing.T) {
+	failOn, err := parseFailOn("")
+	if err != nil {
+		t.Fatalf("parseFailOn(\"\"): %v", err)
+	}
+	if failOn(models.SeverityCritical) {
+		t.Error("empty fail_on should never fail, even for critical severity")
+	}
+}
+
+func TestParseFailOnComparators(t *testing.T) {
+	tests := []struct {
+		expr string
+		sev  models.Severity
+		want bool
+	}{
+		{"severity>=high", models.SeverityHigh, true},
+		{"severity>=high", models.SeverityMedium, false},
+		{"severity>=high", models.SeverityCritical, true},
+		{"severity>high", models.SeverityHigh, false},
+		{"severity==medium", models.SeverityMedium, true},
+		{"severity==medium", models.SeverityHigh, false},
+		{"severity<=low", models.SeverityLow, true},
+	}
+	for _, tt := range tests {
+		failOn, err := parseFailOn(tt.expr)
+		if err != nil {
+			t.Fatalf("parseFailOn(%q): %v", tt.expr, err)
+		}
+		if got := failOn(tt.sev); got != tt.want {
+			t.Errorf("parseFailOn(%q)(%v) = %v, want %v", tt.expr, tt.sev, got, tt.want)
+		}
+	}
+}
+
+func TestParseFailOnInvalidExpression(t *testing.T) {
+	if _, err := parseFailOn("garbage"); err == nil {
+		t.Error("parseFailOn with a malformed expression returned nil error")
+	}
+	if _, err := parseFailOn("severity>=ludicrous"); err == nil {
+		t.Error("parseFailOn with an unknown severity name returned nil error")
+	}
+}
+
+func TestFindingPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		f      models.Finding
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "from Values[filePath]",
+			f:      models.Finding{Values: map[string]string{"filePath": "pkg/main.go"}},
+			want:   "pkg/main.go",
+			wantOK: true,
+		},
+		{
+			name:   "from evidence location prefix",
+			f:      models.Finding{Evidence: []string{"internal/security/security.go:42 - hardcoded secret"}},
+			want:   "internal/security/security.go",
+			wantOK: true,
+		},
+		{
+			name:   "no location available",
+			f:      models.Finding{Evidence: []string{"5 TODO markers found"}},
+			want:   "",
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := findingPath(tt.f)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("findingPath(%+v) = (%q, %v), want (%q, %v)", tt.f, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestPolicyMatchesIgnore(t *testing.T) {
+	p := &Policy{Ignore: []string{"testdata/**", "**/*_generated.go"}}
+
+	if !p.matchesIgnore("testdata/fixtures/secret.txt") {
+		t.Error("expected testdata/** to match")
+	}
+	if !p.matchesIgnore("pkg/api/client_generated.go") {
+		t.Error("expected **/*_generated.go to match")
+	}
+	if p.matchesIgnore("pkg/api/client.go") {
+		t.Error("expected pkg/api/client.go not to match any ignore pattern")
+	}
+}
+
+func TestEvaluateFailOnThreshold(t *testing.T) {
+	p := &Policy{FailOn: "severity>=high"}
+	findings := []models.Finding{
+		{Title: "a", Severity: models.SeverityHigh},
+		{Title: "b", Severity: models.SeverityLow},
+	}
+
+	decision, err := p.Evaluate(findings, time.Now())
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !decision.Fail {
+		t.Error("decision.Fail = false, want true")
+	}
+	if len(decision.Violations) != 1 || decision.Violations[0].Title != "a" {
+		t.Errorf("Violations = %+v, want just finding a", decision.Violations)
+	}
+}
+
+func TestEvaluateIgnoresPathMatch(t *testing.T) {
+	p := &Policy{FailOn: "severity>=low", Ignore: []string{"testdata/**"}}
+	findings := []models.Finding{
+		{Title: "fixture secret", Severity: models.SeverityCritical, Values: map[string]string{"filePath": "testdata/fixture.go"}},
+	}
+
+	decision, err := p.Evaluate(findings, time.Now())
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if decision.Fail {
+		t.Error("decision.Fail = true, want false (finding path is ignored)")
+	}
+	if len(decision.Findings) != 1 || len(decision.Findings[0].Flags) != 1 || decision.Findings[0].Flags[0].Type != models.FlagPolicyIgnored {
+		t.Errorf("Findings = %+v, want a single finding flagged FlagPolicyIgnored", decision.Findings)
+	}
+}
+
+func TestEvaluateActiveWaiverExcludesFinding(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := &Policy{
+		FailOn: "severity>=low",
+		Waivers: []Waiver{
+			{FindingID: "sig-1", Until: now.Add(24 * time.Hour), Reason: "tracked in JIRA-123"},
+		},
+	}
+	findings := []models.Finding{
+		{Title: "waived", Severity: models.SeverityHigh, Signatures: []models.FindingSignature{{Algorithm: "default", Value: "sig-1"}}},
+	}
+
+	decision, err := p.Evaluate(findings, now)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if decision.Fail {
+		t.Error("decision.Fail = true, want false (waiver is active)")
+	}
+	if len(decision.Findings[0].Flags) != 1 || decision.Findings[0].Flags[0].Type != models.FlagWaived {
+		t.Errorf("Flags = %+v, want a single FlagWaived", decision.Findings[0].Flags)
+	}
+}
+
+func TestEvaluateExpiredWaiverStillFails(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := &Policy{
+		FailOn: "severity>=low",
+		Waivers: []Waiver{
+			{FindingID: "sig-1", Until: now.Add(-24 * time.Hour), Reason: "expired"},
+		},
+	}
+	findings := []models.Finding{
+		{Title: "expired-waiver", Severity: models.SeverityHigh, Signatures: []models.FindingSignature{{Algorithm: "default", Value: "sig-1"}}},
+	}
+
+	decision, err := p.Evaluate(findings, now)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !decision.Fail {
+		t.Error("decision.Fail = false, want true (waiver has expired)")
+	}
+}
+
+func TestEvaluateProbeOverrideCountBudget(t *testing.T) {
+	p := &Policy{
+		FailOn: "severity>=critical", // would not fail these on its own
+		Probes: map[string]ProbeOverride{"highTodoCount": {Max: 2}},
+	}
+	findings := []models.Finding{
+		{Title: "1", ProbeID: "highTodoCount", Severity: models.SeverityLow},
+		{Title: "2", ProbeID: "highTodoCount", Severity: models.SeverityLow},
+		{Title: "3", ProbeID: "highTodoCount", Severity: models.SeverityLow},
+	}
+
+	decision, err := p.Evaluate(findings, time.Now())
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if !decision.Fail {
+		t.Error("decision.Fail = false, want true (3 findings exceeds Max=2)")
+	}
+	if len(decision.Violations) != 3 {
+		t.Errorf("Violations = %+v, want all 3 findings once the probe's budget is exceeded", decision.Violations)
+	}
+}
+
+func TestEvaluateProbeOverrideUnderBudgetPasses(t *testing.T) {
+	p := &Policy{
+		Probes: map[string]ProbeOverride{"highTodoCount": {Max: 5}},
+	}
+	findings := []models.Finding{
+		{Title: "1", ProbeID: "highTodoCount", Severity: models.SeverityCritical},
+	}
+
+	decision, err := p.Evaluate(findings, time.Now())
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if decision.Fail {
+		t.Error("decision.Fail = true, want false (1 finding is under Max=5)")
+	}
+}
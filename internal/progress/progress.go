@@ -0,0 +1,30 @@
+// Package progress reports scan phase progress to the user, in the spirit
+// of KICS's ProgressBar: each analysis phase announces itself with a known
+// total, ticks as it makes headway, and signals when it's done, so a long
+// scan shows meaningful progress instead of a bare spinner.
+package progress
+
+// Reporter tracks progress across a sequence of named phases. Callers own
+// phase boundaries: Start begins a phase, Increment reports headway within
+// it, and Done closes it out. Implementations must tolerate a phase whose
+// total isn't known in advance (total == 0) by rendering indeterminate
+// progress instead of a percentage.
+type Reporter interface {
+	// Start begins a new phase labeled label with the given total unit
+	// count (e.g. file count), or 0 if the total isn't known upfront.
+	Start(label string, total int)
+	// Increment reports that n more units of the current phase completed.
+	Increment(n int)
+	// Done closes out the current phase.
+	Done()
+}
+
+// Noop is a Reporter that discards every event, used whenever the caller
+// hasn't asked for progress output (e.g. non-interactive runs).
+var Noop Reporter = noopReporter{}
+
+type noopReporter struct{}
+
+func (noopReporter) Start(string, int) {}
+func (noopReporter) Increment(int)     {}
+func (noopReporter) Done()             {}
@@ -0,0 +1,56 @@
+package progress
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const barWidth = 30
+
+// Terminal renders each phase as a single overwritten line on w (typically
+// os.Stderr, so it never mixes into a piped -format=json/sarif report on
+// stdout). It is not safe for concurrent use.
+type Terminal struct {
+	w       io.Writer
+	label   string
+	total   int
+	current int
+}
+
+// NewTerminal returns a Terminal reporter writing to w.
+func NewTerminal(w io.Writer) *Terminal {
+	return &Terminal{w: w}
+}
+
+func (t *Terminal) Start(label string, total int) {
+	t.label = label
+	t.total = total
+	t.current = 0
+	t.render()
+}
+
+func (t *Terminal) Increment(n int) {
+	t.current += n
+	if t.total > 0 && t.current > t.total {
+		t.current = t.total
+	}
+	t.render()
+}
+
+func (t *Terminal) Done() {
+	t.current = t.total
+	t.render()
+	fmt.Fprintln(t.w)
+}
+
+func (t *Terminal) render() {
+	if t.total <= 0 {
+		fmt.Fprintf(t.w, "\r%-12s [%s]", t.label, strings.Repeat(".", t.current%barWidth+1))
+		return
+	}
+
+	filled := t.current * barWidth / t.total
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", barWidth-filled)
+	fmt.Fprintf(t.w, "\r%-12s [%s] %d/%d", t.label, bar, t.current, t.total)
+}
@@ -0,0 +1,92 @@
+package reporter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/detective-cli/detective/internal/inference"
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// GenerateDiffReport renders the findings delta between two scans of the
+// same target (prev is the baseline, curr the scan being gated) as a
+// human-readable summary: a health-score delta line, then one section per
+// inference.DiffFindings category in the order a reviewer acts on them -
+// new findings first, resolved ones next, severity-changed ones last.
+// Persisted findings are summarized as a count only, since nothing about
+// them changed.
+func GenerateDiffReport(prev, curr models.Report) string {
+	return GenerateDiffReportStyled(prev, curr, false)
+}
+
+// GenerateDiffReportStyled is GenerateDiffReport with optional color styling.
+func GenerateDiffReportStyled(prev, curr models.Report, colorEnabled bool) string {
+	style := newStyler(colorEnabled)
+	diff := inference.DiffFindings(prev.Findings, curr.Findings)
+
+	var sb strings.Builder
+	sb.WriteString(style.section("◼ HEALTH DELTA\n\n"))
+	sb.WriteString(healthDeltaLine(prev.HealthScore, curr.HealthScore, style))
+	sb.WriteString("\n")
+
+	sb.WriteString(style.section(fmt.Sprintf("◼ NEW FINDINGS (%d)\n\n", len(diff.Added))))
+	sb.WriteString(diffFindingListStyled(diff.Added, style))
+	sb.WriteString("\n")
+
+	sb.WriteString(style.section(fmt.Sprintf("◼ RESOLVED FINDINGS (%d)\n\n", len(diff.Resolved))))
+	sb.WriteString(diffFindingListStyled(diff.Resolved, style))
+	sb.WriteString("\n")
+
+	sb.WriteString(style.section(fmt.Sprintf("◼ SEVERITY-CHANGED FINDINGS (%d)\n\n", len(diff.Changed))))
+	sb.WriteString(diffChangedListStyled(diff.Changed, style))
+	sb.WriteString("\n")
+
+	sb.WriteString(style.label("Unchanged: %d\n", len(diff.Persisted)))
+
+	return sb.String()
+}
+
+// healthDeltaLine renders "Health: prev -> curr (+/-delta)", colored low
+// (the same color severityTag uses for a good/low-severity outcome) when
+// the score improved and high (the same color it uses for critical/high
+// severity) when it regressed, reusing the report's existing severity
+// palette rather than inventing a new one.
+func healthDeltaLine(prevScore, currScore int, style styler) string {
+	delta := currScore - prevScore
+	tmpl := "Health: %d → %d (%+d)\n"
+
+	switch {
+	case delta > 0:
+		return style.low(tmpl, prevScore, currScore, delta)
+	case delta < 0:
+		return style.high(tmpl, prevScore, currScore, delta)
+	default:
+		return style.label(tmpl, prevScore, currScore, delta)
+	}
+}
+
+func diffFindingListStyled(findings []models.Finding, style styler) string {
+	if len(findings) == 0 {
+		return style.info("  (none)\n")
+	}
+
+	var sb strings.Builder
+	for _, f := range findings {
+		sb.WriteString(fmt.Sprintf("  %s %s\n", severityTag(f.Severity, style), f.Title))
+	}
+	return sb.String()
+}
+
+func diffChangedListStyled(changes []models.FindingChange, style styler) string {
+	if len(changes) == 0 {
+		return style.info("  (none)\n")
+	}
+
+	var sb strings.Builder
+	for _, c := range changes {
+		sb.WriteString(fmt.Sprintf("  %s %s: %s → %s\n",
+			severityTag(c.Current.Severity, style), c.Current.Title,
+			c.Previous.Severity.String(), c.Current.Severity.String()))
+	}
+	return sb.String()
+}
@@ -0,0 +1,80 @@
+package reporter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestGenerateDiffReportStyledSectionCounts(t *testing.T) {
+	prev := models.Report{
+		HealthScore: 70,
+		Findings: []models.Finding{
+			{Title: "Resolved Finding", Severity: models.SeverityLow, Signatures: []models.FindingSignature{{Algorithm: "default", Value: "resolved"}}},
+			{Title: "Persisted Finding", Severity: models.SeverityMedium, Signatures: []models.FindingSignature{{Algorithm: "default", Value: "persisted"}}},
+			{Title: "Changed Finding", Severity: models.SeverityLow, Signatures: []models.FindingSignature{{Algorithm: "default", Value: "changed"}}},
+		},
+	}
+	curr := models.Report{
+		HealthScore: 55,
+		Findings: []models.Finding{
+			{Title: "Persisted Finding", Severity: models.SeverityMedium, Signatures: []models.FindingSignature{{Algorithm: "default", Value: "persisted"}}},
+			{Title: "Changed Finding", Severity: models.SeverityCritical, Signatures: []models.FindingSignature{{Algorithm: "default", Value: "changed"}}},
+			{Title: "New Finding", Severity: models.SeverityHigh, Signatures: []models.FindingSignature{{Algorithm: "default", Value: "new"}}},
+		},
+	}
+
+	report := GenerateDiffReportStyled(prev, curr, false)
+
+	if !strings.Contains(report, "Health: 70 → 55 (-15)") {
+		t.Errorf("report missing health delta line, got:\n%s", report)
+	}
+	if !strings.Contains(report, "NEW FINDINGS (1)") || !strings.Contains(report, "New Finding") {
+		t.Errorf("report missing new finding section, got:\n%s", report)
+	}
+	if !strings.Contains(report, "RESOLVED FINDINGS (1)") || !strings.Contains(report, "Resolved Finding") {
+		t.Errorf("report missing resolved finding section, got:\n%s", report)
+	}
+	if !strings.Contains(report, "SEVERITY-CHANGED FINDINGS (1)") || !strings.Contains(report, "LOW → CRITICAL") {
+		t.Errorf("report missing severity-changed section, got:\n%s", report)
+	}
+	if !strings.Contains(report, "Unchanged: 1") {
+		t.Errorf("report missing unchanged count, got:\n%s", report)
+	}
+}
+
+func TestHealthDeltaLineUnstyled(t *testing.T) {
+	style := newStyler(false)
+
+	if got := healthDeltaLine(50, 60, style); got != "Health: 50 → 60 (+10)\n" {
+		t.Errorf("improved delta = %q, want plain +10 line", got)
+	}
+	if got := healthDeltaLine(60, 50, style); got != "Health: 60 → 50 (-10)\n" {
+		t.Errorf("regressed delta = %q, want plain -10 line", got)
+	}
+	if got := healthDeltaLine(50, 50, style); got != "Health: 50 → 50 (+0)\n" {
+		t.Errorf("unchanged delta = %q, want plain +0 line", got)
+	}
+}
+
+func TestDiffFindingListStyledEmpty(t *testing.T) {
+	style := newStyler(false)
+	if got := diffFindingListStyled(nil, style); got != "  (none)\n" {
+		t.Errorf("diffFindingListStyled(nil) = %q, want (none) placeholder", got)
+	}
+}
+
+func TestDiffChangedListStyled(t *testing.T) {
+	style := newStyler(false)
+	changes := []models.FindingChange{
+		{
+			Previous: models.Finding{Title: "X", Severity: models.SeverityLow},
+			Current:  models.Finding{Title: "X", Severity: models.SeverityHigh},
+		},
+	}
+	got := diffChangedListStyled(changes, style)
+	if !strings.Contains(got, "X: LOW → HIGH") {
+		t.Errorf("diffChangedListStyled = %q, want a line naming both severities", got)
+	}
+}
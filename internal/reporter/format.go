@@ -0,0 +1,135 @@
+package reporter
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+const ansiReset = "\x1b[0m"
+
+// formatPlaceholder matches one git-log-style pretty-format directive.
+// %>(\d+) and %Creset must come before the catch-all %[Stdpn] alternative
+// so a width directive or color reset isn't mistaken for a bare %d/%S/etc.
+var formatPlaceholder = regexp.MustCompile(`%>\(\d+\)|%sC|%Creset|%[Stdpn]`)
+
+// formatPathLocation matches the "file:line - description" shape
+// internal/inference's evidence builders format security findings' Evidence
+// into, the same convention pkg/report/sarif and pkg/report/export rely on
+// for locations.
+var formatPathLocation = regexp.MustCompile(`^(.+):(\d+) - `)
+
+// FormatTemplate renders report's findings through tmpl, a git-log-style
+// pretty-format string: tmpl is expanded once per finding (sorted by
+// severity, highest first, as generateFindingsSectionStyled does), and each
+// finding's rendering is joined by a newline. This lets a caller pipe
+// Detective into a script the way `git log --format` or `hub issue -f` do,
+// e.g.:
+//
+//	detective --pretty-format '%sC[%>(8)%S]%Creset %t (%p)' .
+//
+// Supported placeholders:
+//
+//	%sC      ANSI color for the finding's severity
+//	%Creset  ANSI reset
+//	%S       severity name (e.g. HIGH)
+//	%t       title
+//	%d       description
+//	%p       file path, from the finding's first "file:line - ..." evidence line (empty if none)
+//	%n       newline
+//	%>(N)    right-align the very next value placeholder's (%S/%t/%d/%p) output to N columns
+func FormatTemplate(report models.Report, tmpl string) string {
+	findings := append([]models.Finding(nil), report.Findings...)
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Severity > findings[j].Severity
+	})
+
+	lines := make([]string, len(findings))
+	for i, f := range findings {
+		lines[i] = expandFormat(f, tmpl)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func expandFormat(f models.Finding, tmpl string) string {
+	var sb strings.Builder
+	pendingWidth := 0
+	pos := 0
+
+	for _, m := range formatPlaceholder.FindAllStringIndex(tmpl, -1) {
+		sb.WriteString(tmpl[pos:m[0]])
+		token := tmpl[m[0]:m[1]]
+		pos = m[1]
+
+		switch {
+		case strings.HasPrefix(token, "%>("):
+			pendingWidth, _ = strconv.Atoi(token[3 : len(token)-1])
+			continue
+		case token == "%sC":
+			sb.WriteString(severityANSI(f.Severity))
+			continue
+		case token == "%Creset":
+			sb.WriteString(ansiReset)
+			continue
+		case token == "%n":
+			sb.WriteString("\n")
+			continue
+		}
+
+		value := formatValue(f, token)
+		if pendingWidth > 0 {
+			value = fmt.Sprintf("%*s", pendingWidth, value)
+			pendingWidth = 0
+		}
+		sb.WriteString(value)
+	}
+	sb.WriteString(tmpl[pos:])
+
+	return sb.String()
+}
+
+func formatValue(f models.Finding, token string) string {
+	switch token {
+	case "%S":
+		return f.Severity.String()
+	case "%t":
+		return f.Title
+	case "%d":
+		return f.Description
+	case "%p":
+		return formatPath(f)
+	default:
+		return ""
+	}
+}
+
+// formatPath returns the file from the first evidence line shaped like
+// "file:line - description", or "" if no evidence line matches.
+func formatPath(f models.Finding) string {
+	for _, e := range f.Evidence {
+		if m := formatPathLocation.FindStringSubmatch(e); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+// severityANSI returns the raw ANSI color escape matching the palette
+// severityTag renders in color mode, so %sC output lines up with the
+// default colored report.
+func severityANSI(sev models.Severity) string {
+	switch sev {
+	case models.SeverityCritical, models.SeverityHigh:
+		return "\x1b[31m"
+	case models.SeverityMedium:
+		return "\x1b[33m"
+	case models.SeverityLow:
+		return "\x1b[36m"
+	default:
+		return "\x1b[37m"
+	}
+}
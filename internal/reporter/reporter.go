@@ -82,6 +82,12 @@ func GenerateReportStyled(report models.Report, colorEnabled bool) string {
 		sb.WriteString("\n")
 	}
 
+	// Release Notes
+	if len(report.ReleaseNotes.Entries) > 0 {
+		sb.WriteString(generateReleaseNotesSectionStyled(report.ReleaseNotes, style))
+		sb.WriteString("\n")
+	}
+
 	// Report Integrity
 	sb.WriteString(generateIntegritySectionStyled(report.ReportHash, report.Context, style))
 
@@ -167,6 +173,22 @@ func generateEvidenceSectionStyled(evidence models.Evidence, style styler) strin
 		sb.WriteString(style.label("  No code markers detected\n"))
 	}
 
+	// Code Smells
+	sb.WriteString("\n")
+	sb.WriteString(style.label("▸ CODE SMELL DETECTION\n"))
+	if len(evidence.CodeSmells) > 0 {
+		smellCounts := make(map[models.CodeSmellType]int)
+		for _, smell := range evidence.CodeSmells {
+			smellCounts[smell.Type]++
+		}
+		sb.WriteString(style.label("  Total Smells: %d\n", len(evidence.CodeSmells)))
+		for smellType, count := range smellCounts {
+			sb.WriteString(style.label("    %s: %d\n", smellType, count))
+		}
+	} else {
+		sb.WriteString(style.label("  No code smells detected\n"))
+	}
+
 	return sb.String()
 }
 
@@ -187,7 +209,13 @@ func generateFindingsSectionStyled(findings []models.Finding, style styler) stri
 
 	for i, finding := range findings {
 		label := severityTag(finding.Severity, style)
-		sb.WriteString(fmt.Sprintf("%s %s\n", label, finding.Title))
+		title := finding.Title
+		if flag, ok := suppressionFlag(finding); ok {
+			title += style.dim(" [suppressed: %s - %s]", flag.Type, flag.Reason)
+		} else if flag, ok := policyFlag(finding); ok {
+			title += style.dim(" [ %s ] %s", policyTagText(flag.Type), flag.Reason)
+		}
+		sb.WriteString(fmt.Sprintf("%s %s\n", label, title))
 		sb.WriteString(style.label("  %s\n", finding.Description))
 		if len(finding.Evidence) > 0 {
 			sb.WriteString(style.dim("  Evidence:\n"))
@@ -195,6 +223,7 @@ func generateFindingsSectionStyled(findings []models.Finding, style styler) stri
 				sb.WriteString(style.label("    - %s\n", evidence))
 			}
 		}
+		sb.WriteString(generateValuesBlockStyled(finding, style))
 		if i < len(findings)-1 {
 			sb.WriteString("\n")
 		}
@@ -252,6 +281,44 @@ func generateNotesSectionStyled(notes []string, style styler) string {
 	return sb.String()
 }
 
+// generateReleaseNotesSectionStyled renders the categorized commit log
+// produced by pkg/relnotes, grouping entries by category in the same
+// Breaking/Features/Fixes/Other order the package classifies them in.
+func generateReleaseNotesSectionStyled(notes models.ReleaseNotes, style styler) string {
+	var sb strings.Builder
+
+	sb.WriteString(style.section("◼ RELEASE NOTES (%s..%s)\n\n", notes.FromRef, notes.ToRef))
+
+	order := []models.ReleaseNoteCategory{
+		models.ReleaseNoteBreaking,
+		models.ReleaseNoteFeature,
+		models.ReleaseNoteFix,
+		models.ReleaseNoteOther,
+	}
+	for _, category := range order {
+		var entries []models.ReleaseNoteEntry
+		for _, e := range notes.Entries {
+			if e.Category == category {
+				entries = append(entries, e)
+			}
+		}
+		if len(entries) == 0 {
+			continue
+		}
+
+		sb.WriteString(style.label("▸ %s\n", category))
+		for _, e := range entries {
+			line := fmt.Sprintf("  - %s (%s)", e.Subject, e.Hash)
+			if e.PRNumber != "" {
+				line += fmt.Sprintf(" #%s", e.PRNumber)
+			}
+			sb.WriteString(style.info("%s\n", line))
+		}
+	}
+
+	return sb.String()
+}
+
 func generateIntegritySectionStyled(hash string, context string, style styler) string {
 	var sb strings.Builder
 
@@ -268,6 +335,71 @@ func generateIntegritySectionStyled(hash string, context string, style styler) s
 	return sb.String()
 }
 
+// generateValuesBlockStyled renders a finding's probe metadata as a stable,
+// machine-parseable "key=value" block: one line per Values entry, sorted by
+// key so the same finding renders identically across runs regardless of
+// map iteration order, plus the probe id and outcome when the finding was
+// rendered from a probe (see models.Finding.ProbeID/Outcome). Findings with
+// neither carry no block at all, same as before this was added.
+func generateValuesBlockStyled(finding models.Finding, style styler) string {
+	if finding.ProbeID == "" && len(finding.Values) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(style.dim("  Values:\n"))
+	if finding.ProbeID != "" {
+		sb.WriteString(style.label("    probe=%s\n", finding.ProbeID))
+	}
+	if finding.Outcome != "" {
+		sb.WriteString(style.label("    outcome=%s\n", finding.Outcome))
+	}
+
+	keys := make([]string, 0, len(finding.Values))
+	for k := range finding.Values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		sb.WriteString(style.label("    %s=%s\n", k, finding.Values[k]))
+	}
+
+	return sb.String()
+}
+
+// suppressionFlag returns the flag that exempted finding from health-score
+// deductions (see inference.IsSuppressedFromScoring), if any, so the report
+// can mark it as suppressed without hiding it entirely.
+func suppressionFlag(finding models.Finding) (models.FindingFlag, bool) {
+	for _, flag := range finding.Flags {
+		if flag.Type == models.FlagFalsePositive || flag.Type == models.FlagAcceptedRisk {
+			return flag, true
+		}
+	}
+	return models.FindingFlag{}, false
+}
+
+// policyFlag returns the internal/policy flag (waived or path-ignored)
+// attached to finding, if any, so the report can tag it distinctly from a
+// manual suppression.
+func policyFlag(finding models.Finding) (models.FindingFlag, bool) {
+	for _, flag := range finding.Flags {
+		if flag.Type == models.FlagWaived || flag.Type == models.FlagPolicyIgnored {
+			return flag, true
+		}
+	}
+	return models.FindingFlag{}, false
+}
+
+// policyTagText renders a policyFlag's Type as the bracketed tag text the
+// report shows inline next to a finding's title.
+func policyTagText(t models.FindingFlagType) string {
+	if t == models.FlagWaived {
+		return "WAIVED"
+	}
+	return "IGNORED"
+}
+
 func severityTag(sev models.Severity, style styler) string {
 	switch sev {
 	case models.SeverityCritical, models.SeverityHigh:
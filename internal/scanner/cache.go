@@ -0,0 +1,129 @@
+package scanner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// CacheEntry records enough about a previously scanned file to skip
+// reopening it on a later scan: its stat fingerprint, the code markers
+// RunWalk extracted from it, and the category it assigned it.
+type CacheEntry struct {
+	ModTime  time.Time           `json:"mod_time"`
+	Size     int64               `json:"size"`
+	SHA256   string              `json:"sha256"`
+	Markers  []models.CodeMarker `json:"markers,omitempty"`
+	Category models.FileCategory `json:"category,omitempty"`
+}
+
+// matchesStat reports whether modTime/size match the entry closely enough
+// to trust its cached Markers/Category without rehashing the file.
+func (e CacheEntry) matchesStat(modTime time.Time, size int64) bool {
+	return e.ModTime.Equal(modTime) && e.Size == size
+}
+
+// Cache is the pluggable store ScanOptions.Cache implements, keyed by
+// absolute path. FileCache backs it with .detective/scan-cache.json; tests
+// can inject their own in-memory implementation instead.
+type Cache interface {
+	Get(path string) (CacheEntry, bool)
+	Put(path string, entry CacheEntry)
+}
+
+const scanCacheFileName = "scan-cache.json"
+
+// FileCache is a JSON-backed Cache, mirroring how internal/pipeline.Cache
+// persists its own security-scan cache alongside it in the same
+// .detective directory.
+type FileCache struct {
+	mu      sync.Mutex
+	entries map[string]CacheEntry
+}
+
+// LoadFileCache reads dir/scan-cache.json, returning an empty cache if it
+// does not exist yet or fails to parse.
+func LoadFileCache(dir string) *FileCache {
+	fc := &FileCache{entries: make(map[string]CacheEntry)}
+
+	data, err := os.ReadFile(filepath.Join(dir, scanCacheFileName))
+	if err != nil {
+		return fc
+	}
+	if err := json.Unmarshal(data, &fc.entries); err != nil {
+		fc.entries = make(map[string]CacheEntry)
+	}
+	return fc
+}
+
+// Get implements Cache.
+func (fc *FileCache) Get(path string) (CacheEntry, bool) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	entry, ok := fc.entries[path]
+	return entry, ok
+}
+
+// Put implements Cache.
+func (fc *FileCache) Put(path string, entry CacheEntry) {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+	fc.entries[path] = entry
+}
+
+// Save writes the cache to dir/scan-cache.json, creating dir if needed.
+func (fc *FileCache) Save(dir string) error {
+	fc.mu.Lock()
+	defer fc.mu.Unlock()
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(fc.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, scanCacheFileName), data, 0o644)
+}
+
+// rebuildingCache wraps a Cache so every Get reports a miss while Put still
+// writes through, letting --rebuild-cache force a full rescan while still
+// refreshing the on-disk cache for the next run.
+type rebuildingCache struct {
+	Cache
+}
+
+// Get always misses; Put is inherited from the embedded Cache.
+func (rebuildingCache) Get(string) (CacheEntry, bool) {
+	return CacheEntry{}, false
+}
+
+// RebuildCache wraps c so reads always miss but writes still populate it,
+// for CLI flags like --rebuild-cache that want a full rescan without
+// discarding the cache file entirely.
+func RebuildCache(c Cache) Cache {
+	return rebuildingCache{Cache: c}
+}
+
+// fileDigest hashes path's contents with SHA-256, used as the final record
+// of what was scanned; mirrors internal/pipeline's fileDigest.
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,109 @@
+package scanner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestCacheEntryMatchesStat(t *testing.T) {
+	modTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	entry := CacheEntry{ModTime: modTime, Size: 1024}
+
+	if !entry.matchesStat(modTime, 1024) {
+		t.Error("matchesStat should match on identical mod time and size")
+	}
+	if entry.matchesStat(modTime.Add(time.Second), 1024) {
+		t.Error("matchesStat should not match on a different mod time")
+	}
+	if entry.matchesStat(modTime, 2048) {
+		t.Error("matchesStat should not match on a different size")
+	}
+}
+
+func TestFileCacheGetPut(t *testing.T) {
+	fc := LoadFileCache(t.TempDir())
+
+	if _, ok := fc.Get("/some/file.go"); ok {
+		t.Fatal("Get on empty cache returned ok=true")
+	}
+
+	entry := CacheEntry{
+		SHA256:   "abc123",
+		Size:     42,
+		Category: models.CategorySource,
+		Markers:  []models.CodeMarker{{Type: "TODO", File: "/some/file.go", Line: 3}},
+	}
+	fc.Put("/some/file.go", entry)
+
+	got, ok := fc.Get("/some/file.go")
+	if !ok {
+		t.Fatal("Get after Put returned ok=false")
+	}
+	if got.SHA256 != "abc123" || got.Category != models.CategorySource || len(got.Markers) != 1 {
+		t.Errorf("Get = %+v, want %+v", got, entry)
+	}
+}
+
+func TestFileCacheSaveLoadRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+
+	fc := LoadFileCache(dir)
+	fc.Put("/project/main.go", CacheEntry{SHA256: "deadbeef", Size: 100, Category: models.CategorySource})
+	if err := fc.Save(dir); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	reloaded := LoadFileCache(dir)
+	got, ok := reloaded.Get("/project/main.go")
+	if !ok || got.SHA256 != "deadbeef" {
+		t.Errorf("reloaded Get = %+v, ok=%v, want sha256=deadbeef", got, ok)
+	}
+}
+
+func TestLoadFileCacheMissingFileReturnsEmptyCache(t *testing.T) {
+	fc := LoadFileCache(t.TempDir())
+	if _, ok := fc.Get("/anything"); ok {
+		t.Error("Get on a freshly loaded empty cache returned ok=true")
+	}
+}
+
+func TestRebuildCacheAlwaysMissesButStillWritesThrough(t *testing.T) {
+	fc := LoadFileCache(t.TempDir())
+	fc.Put("/cached/file.go", CacheEntry{SHA256: "already-here"})
+
+	rebuilding := RebuildCache(fc)
+
+	if _, ok := rebuilding.Get("/cached/file.go"); ok {
+		t.Error("RebuildCache.Get should always report a miss, even for an entry present in the underlying cache")
+	}
+
+	rebuilding.Put("/cached/file.go", CacheEntry{SHA256: "refreshed"})
+	got, ok := fc.Get("/cached/file.go")
+	if !ok || got.SHA256 != "refreshed" {
+		t.Errorf("underlying cache after Put through RebuildCache = %+v, ok=%v, want sha256=refreshed", got, ok)
+	}
+}
+
+func TestFileDigest(t *testing.T) {
+	content := []byte("hello world")
+	path := filepath.Join(t.TempDir(), "sample.txt")
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	got, err := fileDigest(path)
+	if err != nil {
+		t.Fatalf("fileDigest: %v", err)
+	}
+	sum := sha256.Sum256(content)
+	want := hex.EncodeToString(sum[:])
+	if got != want {
+		t.Errorf("fileDigest = %q, want %q", got, want)
+	}
+}
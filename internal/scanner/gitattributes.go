@@ -0,0 +1,125 @@
+package scanner
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// linguistAttr is one of the two linguist attributes detective recognizes
+// in a .gitattributes file.
+type linguistAttr int
+
+const (
+	linguistGenerated linguistAttr = iota
+	linguistVendored
+)
+
+// linguistRule pairs a path pattern (reusing gitignore's glob matching,
+// since .gitattributes patterns follow the same fnmatch-style syntax) with
+// the linguist attribute it declares.
+type linguistRule struct {
+	pattern gitignore.Pattern
+	attr    linguistAttr
+}
+
+// gitattributes holds every linguist-generated/linguist-vendored rule found
+// across a tree's .gitattributes files, in declaration order, so a later
+// (more specific) rule can override an earlier one the same way git itself
+// resolves attributes. A nil *gitattributes' override is a no-op, so
+// ScanOptions.GitattributesLinguistOverride == false needs no special-casing
+// at the call sites.
+type gitattributes struct {
+	rules []linguistRule
+}
+
+// loadGitattributes walks rootPath collecting linguist-generated and
+// linguist-vendored entries from every .gitattributes file, nested ones
+// included and scoped to their own directory.
+func loadGitattributes(rootPath string) (*gitattributes, error) {
+	var attrs gitattributes
+
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return nil
+		}
+		if info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+
+		rel := relPath(rootPath, path)
+		var domain []string
+		if rel != "." {
+			domain = strings.Split(rel, string(filepath.Separator))
+		}
+
+		rules, err := readGitattributesFile(filepath.Join(path, ".gitattributes"), domain)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		attrs.rules = append(attrs.rules, rules...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &attrs, nil
+}
+
+func readGitattributesFile(path string, domain []string) ([]linguistRule, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var rules []linguistRule
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		pattern := gitignore.ParsePattern(fields[0], domain)
+		for _, attr := range fields[1:] {
+			switch attr {
+			case "linguist-generated", "linguist-generated=true":
+				rules = append(rules, linguistRule{pattern: pattern, attr: linguistGenerated})
+			case "linguist-vendored", "linguist-vendored=true":
+				rules = append(rules, linguistRule{pattern: pattern, attr: linguistVendored})
+			}
+		}
+	}
+	return rules, scanner.Err()
+}
+
+// override returns category unless a later (more specific) linguist rule
+// matches rel, in which case it returns the category that rule implies:
+// linguist-generated maps to CategoryBuildArtifact (it's produced, not
+// authored) and linguist-vendored maps to CategoryDependency, matching how
+// detective already categorizes files under node_modules/vendor.
+func (a *gitattributes) override(rel string, category models.FileCategory) models.FileCategory {
+	if a == nil {
+		return category
+	}
+	segments := strings.Split(rel, string(filepath.Separator))
+	for i := len(a.rules) - 1; i >= 0; i-- {
+		rule := a.rules[i]
+		if rule.pattern.Match(segments, false) == gitignore.NoMatch {
+			continue
+		}
+		switch rule.attr {
+		case linguistGenerated:
+			return models.CategoryBuildArtifact
+		case linguistVendored:
+			return models.CategoryDependency
+		}
+	}
+	return category
+}
@@ -0,0 +1,85 @@
+package scanner
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+// gitignoreMatcher composes every .gitignore found under a tree, each
+// scoped to its own directory, plus the repository-wide .git/info/exclude,
+// into one gitignore.Matcher. A nil *gitignoreMatcher always reports no
+// match, so ScanOptions.RespectGitignore == false needs no special-casing
+// at the call sites.
+type gitignoreMatcher struct {
+	matcher gitignore.Matcher
+}
+
+// loadGitignoreMatcher walks rootPath collecting patterns from every
+// .gitignore file (nested ones included) and rootPath/.git/info/exclude.
+func loadGitignoreMatcher(rootPath string) (*gitignoreMatcher, error) {
+	patterns, err := readGitignoreFile(filepath.Join(rootPath, ".git", "info", "exclude"), nil)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	walkErr := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return nil
+		}
+		if info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+
+		rel := relPath(rootPath, path)
+		var domain []string
+		if rel != "." {
+			domain = strings.Split(rel, string(filepath.Separator))
+		}
+
+		ps, err := readGitignoreFile(filepath.Join(path, ".gitignore"), domain)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		patterns = append(patterns, ps...)
+		return nil
+	})
+	if walkErr != nil {
+		return nil, walkErr
+	}
+
+	return &gitignoreMatcher{matcher: gitignore.NewMatcher(patterns)}, nil
+}
+
+// readGitignoreFile parses path as a .gitignore-style file, scoping every
+// pattern it contains to domain (nil for the repository root).
+func readGitignoreFile(path string, domain []string) ([]gitignore.Pattern, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var patterns []gitignore.Pattern
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		patterns = append(patterns, gitignore.ParsePattern(line, domain))
+	}
+	return patterns, scanner.Err()
+}
+
+// match reports whether rel (a path relative to the scan root, as returned
+// by relPath) is ignored.
+func (m *gitignoreMatcher) match(rel string, isDir bool) bool {
+	if m == nil {
+		return false
+	}
+	return m.matcher.Match(strings.Split(rel, string(filepath.Separator)), isDir)
+}
@@ -0,0 +1,345 @@
+package scanner
+
+import (
+	"bufio"
+	"container/heap"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// FileEvent is one regular file WalkPipeline discovered: everything a
+// downstream worker needs (path, its scan-root-relative form for
+// gitignore/gitattributes matching, and its os.FileInfo) without
+// re-walking the tree or re-statting the file.
+type FileEvent struct {
+	Path string
+	Rel  string
+	Info os.FileInfo
+}
+
+// WalkStats accumulates the directory/file bookkeeping WalkPipeline's own
+// walk goroutine can compute cheaply as it visits each entry, kept
+// separate from the per-file work RunWalk's worker pool does concurrently.
+// It is only ever written by that one goroutine and is safe to read once
+// the FileEvent channel WalkPipeline returns has been drained, the same
+// happens-before guarantee a channel close gives any other single-writer
+// value.
+type WalkStats struct {
+	TotalDirectories  int
+	SkippedDirs       []string
+	SkippedDirsCount  int
+	IgnoredDirsCount  int
+	IgnoredFilesCount int
+}
+
+// WalkPipeline walks root exactly once, emitting a FileEvent per
+// non-skipped, non-ignored regular file on the returned channel and any
+// walk-level error (failing to load .gitignore, or the walk itself) on the
+// error channel. Both channels close when the walk finishes. ctx
+// cancellation stops the walk early rather than letting it run to
+// completion, so the CLI can abort a large scan on SIGINT. stats is filled
+// in as directories are visited; read it only after the event channel
+// closes.
+func WalkPipeline(ctx context.Context, root string, opts ScanOptions, stats *WalkStats) (<-chan FileEvent, <-chan error) {
+	events := make(chan FileEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		ignore, err := maybeLoadGitignoreMatcher(root, opts)
+		if err != nil {
+			errs <- fmt.Errorf("loading .gitignore: %w", err)
+			return
+		}
+
+		walkErr := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			if err != nil {
+				return nil // Skip files we can't access
+			}
+			rel := relPath(root, path)
+
+			if info.IsDir() {
+				if shouldSkipDir(info.Name(), opts) {
+					stats.SkippedDirs = append(stats.SkippedDirs, info.Name())
+					stats.SkippedDirsCount++
+					return filepath.SkipDir
+				}
+				if rel != "." && ignore.match(rel, true) {
+					stats.IgnoredDirsCount++
+					return filepath.SkipDir
+				}
+				stats.TotalDirectories++
+				return nil
+			}
+			if ignore.match(rel, false) {
+				stats.IgnoredFilesCount++
+				return nil
+			}
+
+			select {
+			case events <- FileEvent{Path: path, Rel: rel, Info: info}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			return nil
+		})
+		if walkErr != nil && walkErr != context.Canceled {
+			errs <- walkErr
+		}
+	}()
+
+	return events, errs
+}
+
+// RunWalk fans a single WalkPipeline walk of root out to workers goroutines
+// (0 or negative defaults to runtime.NumCPU()), each categorizing a file,
+// extracting its code markers, and recording its mtime. This replaces what
+// used to be three separate filepath.Walk passes over the same tree
+// (ScanFileSystem, ScanCodeMarkers, AnalyzeTimeline); results are merged
+// into a single FileSystemEvidence, marker slice, and TimelineEvidence by
+// a mutex-guarded accumulator, finalized once every worker has drained the
+// event channel.
+func RunWalk(ctx context.Context, root string, opts ScanOptions, workers int) (models.FileSystemEvidence, []models.CodeMarker, models.TimelineEvidence, error) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	attrs, err := maybeLoadGitattributes(root, opts)
+	if err != nil {
+		return models.FileSystemEvidence{}, nil, models.TimelineEvidence{}, fmt.Errorf("loading .gitattributes: %w", err)
+	}
+
+	codeExtensions := map[string]bool{
+		".go": true, ".js": true, ".ts": true, ".py": true, ".java": true,
+		".c": true, ".cpp": true, ".h": true, ".rs": true, ".rb": true,
+		".php": true, ".cs": true, ".swift": true, ".kt": true,
+	}
+	if opts.OnlyExts != nil && len(opts.OnlyExts) > 0 {
+		codeExtensions = opts.OnlyExts
+	}
+
+	var stats WalkStats
+	events, errs := WalkPipeline(ctx, root, opts, &stats)
+
+	acc := &walkAccumulator{
+		fileTypes: make(map[string]int),
+		largest:   &fileHeap{},
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for event := range events {
+				acc.processFile(event, attrs, codeExtensions, opts)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if walkErr := <-errs; walkErr != nil {
+		return models.FileSystemEvidence{}, nil, models.TimelineEvidence{}, walkErr
+	}
+
+	return acc.finalizeFileSystem(stats), acc.markers, acc.finalizeTimeline(), nil
+}
+
+// walkAccumulator merges the per-file work of RunWalk's worker pool behind
+// a single mutex; contention is negligible next to the cost of opening and
+// scanning each file, so a finer-grained scheme isn't worth the complexity.
+type walkAccumulator struct {
+	mu sync.Mutex
+
+	totalFiles  int
+	totalSize   int64
+	fileTypes   map[string]int
+	categorized models.CategorizedFiles
+	largest     *fileHeap
+
+	markers []models.CodeMarker
+
+	oldestFile time.Time
+	newestFile time.Time
+	fileCount  int
+}
+
+// processFile does the CPU/IO work a worker owns for one file (category,
+// markers, digest-on-cache-miss) before taking the accumulator's lock just
+// long enough to merge the results in.
+func (a *walkAccumulator) processFile(event FileEvent, attrs *gitattributes, codeExtensions map[string]bool, opts ScanOptions) {
+	ext := filepath.Ext(event.Path)
+	displayExt := ext
+	if displayExt == "" {
+		displayExt = "no-extension"
+	}
+	category := attrs.override(event.Rel, categorizeFile(event.Path, ext))
+	cacheCategory(opts.Cache, event.Path, event.Info, category)
+
+	var fileMarkers []models.CodeMarker
+	if codeExtensions[ext] {
+		fileMarkers = extractMarkers(event.Path, event.Info, opts)
+	}
+
+	modTime := event.Info.ModTime()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.totalFiles++
+	a.totalSize += event.Info.Size()
+	a.fileTypes[displayExt]++
+	updateCategoryCounts(&a.categorized, category)
+	addLargestFile(a.largest, models.FileInfo{
+		Path:     event.Path,
+		Size:     event.Info.Size(),
+		Type:     displayExt,
+		Category: category,
+	}, 10)
+
+	a.markers = append(a.markers, fileMarkers...)
+
+	if a.oldestFile.IsZero() || modTime.Before(a.oldestFile) {
+		a.oldestFile = modTime
+	}
+	if a.newestFile.IsZero() || modTime.After(a.newestFile) {
+		a.newestFile = modTime
+	}
+	a.fileCount++
+}
+
+func (a *walkAccumulator) finalizeFileSystem(stats WalkStats) models.FileSystemEvidence {
+	return models.FileSystemEvidence{
+		TotalFiles:        a.totalFiles,
+		TotalDirectories:  stats.TotalDirectories,
+		TotalSize:         a.totalSize,
+		FileTypes:         a.fileTypes,
+		LargestFiles:      a.largest.sortedDescending(),
+		SkippedDirs:       stats.SkippedDirs,
+		SkippedDirsCount:  stats.SkippedDirsCount,
+		IgnoredFilesCount: stats.IgnoredFilesCount,
+		IgnoredDirsCount:  stats.IgnoredDirsCount,
+		CategorizedFiles:  a.categorized,
+	}
+}
+
+func (a *walkAccumulator) finalizeTimeline() models.TimelineEvidence {
+	if a.fileCount == 0 {
+		return models.TimelineEvidence{}
+	}
+
+	timeline := models.TimelineEvidence{
+		OldestFile: a.oldestFile,
+		NewestFile: a.newestFile,
+	}
+	timeline.MostRecentDay = int(time.Since(timeline.NewestFile).Hours() / 24)
+
+	if a.fileCount > 1 {
+		daySpan := int(timeline.NewestFile.Sub(timeline.OldestFile).Hours() / 24)
+		timeline.BurstDaySpan = daySpan
+
+		// If 80% of files modified within 7 days, it's a burst
+		if daySpan <= 7 && a.fileCount > 2 {
+			timeline.ActivityBurst = true
+		}
+	}
+
+	return timeline
+}
+
+// extractMarkers reads path's code maintenance markers, reusing opts.Cache
+// when path's stat fingerprint hasn't changed since it was last scanned.
+func extractMarkers(path string, info os.FileInfo, opts ScanOptions) []models.CodeMarker {
+	if opts.Cache != nil {
+		if entry, ok := opts.Cache.Get(path); ok && entry.matchesStat(info.ModTime(), info.Size()) {
+			return entry.Markers
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+
+	var fileMarkers []models.CodeMarker
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+
+		for markerType, pattern := range markerPatterns {
+			if matches := pattern.FindStringSubmatch(line); matches != nil {
+				fileMarkers = append(fileMarkers, models.CodeMarker{
+					Type:    markerType,
+					File:    path,
+					Line:    lineNum,
+					Content: strings.TrimSpace(line),
+				})
+			}
+		}
+	}
+
+	if opts.Cache != nil {
+		if digest, err := fileDigest(path); err == nil {
+			cacheMarkers(opts.Cache, path, info, digest, fileMarkers)
+		}
+	}
+
+	return fileMarkers
+}
+
+// fileHeap is a min-heap of models.FileInfo ordered by Size, letting
+// addLargestFile keep the top N largest files seen without sorting the
+// full file list the way a bubble sort would.
+type fileHeap []models.FileInfo
+
+func (h fileHeap) Len() int           { return len(h) }
+func (h fileHeap) Less(i, j int) bool { return h[i].Size < h[j].Size }
+func (h fileHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *fileHeap) Push(x any) { *h = append(*h, x.(models.FileInfo)) }
+
+func (h *fileHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
+
+// addLargestFile keeps h bounded to limit entries, the limit largest by
+// Size seen so far.
+func addLargestFile(h *fileHeap, file models.FileInfo, limit int) {
+	if h.Len() < limit {
+		heap.Push(h, file)
+		return
+	}
+	if h.Len() > 0 && (*h)[0].Size < file.Size {
+		heap.Pop(h)
+		heap.Push(h, file)
+	}
+}
+
+// sortedDescending drains h into a slice ordered largest-first.
+func (h *fileHeap) sortedDescending() []models.FileInfo {
+	result := make([]models.FileInfo, h.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(h).(models.FileInfo)
+	}
+	return result
+}
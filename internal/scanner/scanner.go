@@ -1,22 +1,84 @@
 package scanner
 
 import (
-	"bufio"
 	"os"
 	"path/filepath"
 	"regexp"
-	"sort"
 	"strings"
-	"time"
 
 	"github.com/detective-cli/detective/pkg/models"
 )
 
 // ScanOptions controls scanning behavior
 type ScanOptions struct {
-	ExcludeDirs []string        // directory names to skip entirely
-	OnlyExts    map[string]bool // optional allowed file extensions (for code marker scan)
-	SkipHidden  bool            // skip hidden directories/files (names starting with .)
+	ExcludeDirs                   []string        // directory names to skip entirely
+	OnlyExts                      map[string]bool // optional allowed file extensions (for code marker scan)
+	SkipHidden                    bool            // skip hidden directories/files (names starting with .)
+	RespectGitignore              bool            // skip paths matched by .gitignore, nested .gitignore, and .git/info/exclude
+	GitattributesLinguistOverride bool            // recategorize paths marked linguist-generated/linguist-vendored in .gitattributes
+	Cache                         Cache           // optional stat-based cache of per-file category/markers, shared between RunWalk and ScanCodeSmells; nil disables caching
+	ComplexityThreshold           int             // cognitive complexity above which ScanCodeSmells reports a function; 0 defaults to 15
+}
+
+// relPath returns path relative to rootPath using "." for rootPath itself,
+// falling back to path unchanged if it isn't actually under rootPath (e.g.
+// a symlink walked outside it), since gitignoreMatcher.match tolerates that
+// by simply never matching.
+func relPath(rootPath, path string) string {
+	rel, err := filepath.Rel(rootPath, path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
+
+// maybeLoadGitignoreMatcher returns a matcher built from rootPath's
+// .gitignore tree when opts.RespectGitignore is set, or nil otherwise; a
+// nil *gitignoreMatcher's match always returns false, so callers can use it
+// unconditionally without an extra opts check at every call site.
+func maybeLoadGitignoreMatcher(rootPath string, opts ScanOptions) (*gitignoreMatcher, error) {
+	if !opts.RespectGitignore {
+		return nil, nil
+	}
+	return loadGitignoreMatcher(rootPath)
+}
+
+// maybeLoadGitattributes returns the linguist overrides parsed from
+// rootPath's .gitattributes tree when opts.GitattributesLinguistOverride is
+// set, or nil otherwise; a nil *gitattributes' override is a no-op.
+func maybeLoadGitattributes(rootPath string, opts ScanOptions) (*gitattributes, error) {
+	if !opts.GitattributesLinguistOverride {
+		return nil, nil
+	}
+	return loadGitattributes(rootPath)
+}
+
+// cacheCategory records path's stat fingerprint and category in cache,
+// preserving any Markers already cached for it. A nil cache is a no-op.
+func cacheCategory(cache Cache, path string, info os.FileInfo, category models.FileCategory) {
+	if cache == nil {
+		return
+	}
+	entry, _ := cache.Get(path)
+	entry.ModTime = info.ModTime()
+	entry.Size = info.Size()
+	entry.Category = category
+	cache.Put(path, entry)
+}
+
+// cacheMarkers records path's stat fingerprint, digest, and extracted
+// markers in cache, preserving any Category already cached for it. A nil
+// cache is a no-op.
+func cacheMarkers(cache Cache, path string, info os.FileInfo, digest string, markers []models.CodeMarker) {
+	if cache == nil {
+		return
+	}
+	entry, _ := cache.Get(path)
+	entry.ModTime = info.ModTime()
+	entry.Size = info.Size()
+	entry.SHA256 = digest
+	entry.Markers = markers
+	cache.Put(path, entry)
 }
 
 func shouldSkipDir(name string, opts ScanOptions) bool {
@@ -39,202 +101,6 @@ var markerPatterns = map[string]*regexp.Regexp{
 	"NOTE":  regexp.MustCompile(`(?i)//\s*NOTE:?\s*(.+)`),
 }
 
-// ScanFileSystem analyzes the file system at the given path
-func ScanFileSystem(rootPath string, opts ScanOptions) (models.FileSystemEvidence, error) {
-	evidence := models.FileSystemEvidence{
-		FileTypes:    make(map[string]int),
-		LargestFiles: []models.FileInfo{},
-	}
-
-	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return nil // Skip files we can't access
-		}
-
-		// Skip excluded directories
-		if info.IsDir() {
-			if shouldSkipDir(info.Name(), opts) {
-				// record skipped dir
-				evidence.SkippedDirs = append(evidence.SkippedDirs, info.Name())
-				evidence.SkippedDirsCount++
-				return filepath.SkipDir
-			}
-		}
-
-		if info.IsDir() {
-			evidence.TotalDirectories++
-		} else {
-			evidence.TotalFiles++
-			evidence.TotalSize += info.Size()
-
-			// Track file types
-			ext := filepath.Ext(path)
-			if ext == "" {
-				ext = "no-extension"
-			}
-			evidence.FileTypes[ext]++
-
-			// Categorize file
-			category := categorizeFile(path, ext)
-			updateCategoryCounts(&evidence.CategorizedFiles, category)
-
-			// Track large files (top 10)
-			fileInfo := models.FileInfo{
-				Path:     path,
-				Size:     info.Size(),
-				Type:     ext,
-				Category: category,
-			}
-			evidence.LargestFiles = append(evidence.LargestFiles, fileInfo)
-		}
-
-		return nil
-	})
-
-	// Sort and keep only top 10 largest files
-	if len(evidence.LargestFiles) > 1 {
-		// Simple bubble sort for top 10
-		for i := 0; i < len(evidence.LargestFiles)-1; i++ {
-			for j := 0; j < len(evidence.LargestFiles)-i-1; j++ {
-				if evidence.LargestFiles[j].Size < evidence.LargestFiles[j+1].Size {
-					evidence.LargestFiles[j], evidence.LargestFiles[j+1] =
-						evidence.LargestFiles[j+1], evidence.LargestFiles[j]
-				}
-			}
-		}
-		if len(evidence.LargestFiles) > 10 {
-			evidence.LargestFiles = evidence.LargestFiles[:10]
-		}
-	}
-
-	return evidence, err
-}
-
-// ScanCodeMarkers searches for code maintenance markers in source files
-func ScanCodeMarkers(rootPath string, opts ScanOptions) ([]models.CodeMarker, error) {
-	var markers []models.CodeMarker
-
-	codeExtensions := map[string]bool{
-		".go": true, ".js": true, ".ts": true, ".py": true, ".java": true,
-		".c": true, ".cpp": true, ".h": true, ".rs": true, ".rb": true,
-		".php": true, ".cs": true, ".swift": true, ".kt": true,
-	}
-
-	// If opts.OnlyExts provided, override default set
-	if opts.OnlyExts != nil && len(opts.OnlyExts) > 0 {
-		codeExtensions = opts.OnlyExts
-	}
-
-	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return nil
-		}
-
-		// Skip directories per options
-		if info.IsDir() {
-			if shouldSkipDir(info.Name(), opts) {
-				return filepath.SkipDir
-			}
-			return nil
-		}
-
-		// Only scan code files
-		ext := filepath.Ext(path)
-		if !codeExtensions[ext] {
-			return nil
-		}
-
-		// Scan file for markers
-		file, err := os.Open(path)
-		if err != nil {
-			return nil
-		}
-		defer file.Close()
-
-		scanner := bufio.NewScanner(file)
-		lineNum := 0
-		for scanner.Scan() {
-			lineNum++
-			line := scanner.Text()
-
-			for markerType, pattern := range markerPatterns {
-				if matches := pattern.FindStringSubmatch(line); matches != nil {
-					content := strings.TrimSpace(line)
-					markers = append(markers, models.CodeMarker{
-						Type:    markerType,
-						File:    path,
-						Line:    lineNum,
-						Content: content,
-					})
-				}
-			}
-		}
-
-		return nil
-	})
-
-	return markers, err
-}
-
-// AnalyzeTimeline analyzes file modification patterns
-func AnalyzeTimeline(rootPath string, opts ScanOptions) (models.TimelineEvidence, error) {
-	timeline := models.TimelineEvidence{}
-	var modTimes []time.Time
-
-	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return nil
-		}
-
-		// Skip directories per options
-		if info.IsDir() {
-			if shouldSkipDir(info.Name(), opts) {
-				return filepath.SkipDir
-			}
-			return nil
-		}
-
-		modTime := info.ModTime()
-		modTimes = append(modTimes, modTime)
-
-		// Track oldest and newest files
-		if timeline.OldestFile.IsZero() || modTime.Before(timeline.OldestFile) {
-			timeline.OldestFile = modTime
-		}
-		if timeline.NewestFile.IsZero() || modTime.After(timeline.NewestFile) {
-			timeline.NewestFile = modTime
-		}
-
-		return nil
-	})
-
-	if err != nil || len(modTimes) == 0 {
-		return timeline, err
-	}
-
-	// Sort times to analyze distribution
-	sort.Slice(modTimes, func(i, j int) bool {
-		return modTimes[i].Before(modTimes[j])
-	})
-
-	// Calculate days since most recent modification
-	timeline.MostRecentDay = int(time.Since(timeline.NewestFile).Hours() / 24)
-
-	// Detect activity bursts (files modified in narrow time window)
-	if len(modTimes) > 1 {
-		timeSpan := timeline.NewestFile.Sub(timeline.OldestFile)
-		daySpan := int(timeSpan.Hours() / 24)
-		timeline.BurstDaySpan = daySpan
-
-		// If 80% of files modified within 7 days, it's a burst
-		if daySpan <= 7 && len(modTimes) > 2 {
-			timeline.ActivityBurst = true
-		}
-	}
-
-	return timeline, nil
-}
-
 // categorizeFile determines the purpose/category of a file
 func categorizeFile(path, ext string) models.FileCategory {
 	lowerPath := strings.ToLower(path)
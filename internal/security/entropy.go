@@ -0,0 +1,180 @@
+package security
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// SecretScanConfig tunes the generic high-entropy secret detector. The
+// built-in named patterns in secretPatterns always run; this config only
+// governs the entropy-based fallback and its allowlisting.
+type SecretScanConfig struct {
+	EntropyThreshold float64  // minimum Shannon entropy (bits/char) to flag a base64-ish token
+	HexEntropyThresh float64  // minimum Shannon entropy for hex-only tokens (lower, since the alphabet is smaller)
+	MinLength        int      // minimum token length to consider
+	AllowlistRegexes []string // lines matching any of these are never flagged
+	AllowlistPaths   []string // doublestar globs (relative to scan root); matching files are skipped entirely
+	Verify           bool     // if true, attempt a live verification call for recognized token shapes
+
+	allowlistRE []*regexp.Regexp
+}
+
+// DefaultSecretScanConfig returns the entropy thresholds recommended by
+// gitleaks/trufflehog for distinguishing random secrets from ordinary
+// identifiers and hashes.
+func DefaultSecretScanConfig() SecretScanConfig {
+	return SecretScanConfig{
+		EntropyThreshold: 4.5,
+		HexEntropyThresh: 3.5,
+		MinLength:        20,
+	}
+}
+
+func (c *SecretScanConfig) compile() {
+	c.allowlistRE = nil
+	for _, pattern := range c.AllowlistRegexes {
+		if re, err := regexp.Compile(pattern); err == nil {
+			c.allowlistRE = append(c.allowlistRE, re)
+		}
+	}
+}
+
+// isPathAllowlisted reports whether relPath (slash-separated, relative to
+// the scan root) matches any of the configured allowlist globs.
+func (c SecretScanConfig) isPathAllowlisted(relPath string) bool {
+	relPath = filepathToSlash(relPath)
+	for _, pattern := range c.AllowlistPaths {
+		if ok, err := doublestar.Match(pattern, relPath); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+func filepathToSlash(p string) string {
+	return strings.ReplaceAll(p, "\\", "/")
+}
+
+// inlineAllowComment matches a trailing `// detective:allow secret` (or `#`
+// for shell/Python-style comments) that suppresses findings on that line.
+var inlineAllowComment = regexp.MustCompile(`(?://|#)\s*detective:allow\s+secret\b`)
+
+// secretTokenRE tokenizes a line on quote/whitespace/`=`/`:` boundaries,
+// capturing candidate secret-shaped runs.
+var secretTokenRE = regexp.MustCompile(`[A-Za-z0-9+/_\-=]{20,}`)
+
+var hexTokenRE = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+
+// shannonEntropy computes H = -Σ p(c)·log2 p(c) over the character
+// frequency distribution of s.
+func shannonEntropy(s string) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+	freq := make(map[rune]int)
+	for _, r := range s {
+		freq[r]++
+	}
+	var entropy float64
+	total := float64(len(s))
+	for _, count := range freq {
+		p := float64(count) / total
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// scanLineForGenericSecrets applies the entropy-based detector to a single
+// line, respecting the inline-ignore comment and configured allowlists.
+func scanLineForGenericSecrets(line string, cfg SecretScanConfig) []string {
+	if inlineAllowComment.MatchString(line) {
+		return nil
+	}
+	for _, re := range cfg.allowlistRE {
+		if re.MatchString(line) {
+			return nil
+		}
+	}
+
+	var hits []string
+	for _, token := range secretTokenRE.FindAllString(line, -1) {
+		if len(token) < cfg.MinLength {
+			continue
+		}
+		if hexTokenRE.MatchString(token) {
+			if shannonEntropy(token) >= cfg.HexEntropyThresh {
+				hits = append(hits, token)
+			}
+			continue
+		}
+		if shannonEntropy(token) >= cfg.EntropyThreshold {
+			hits = append(hits, token)
+		}
+	}
+	return hits
+}
+
+// guessTokenType maps a candidate token's shape to the verification
+// provider that can confirm it, matching the prefixes used elsewhere in
+// secretPatterns (AWS keys have no public verification endpoint, so they
+// are left unrecognized here).
+func guessTokenType(token string) string {
+	switch {
+	case strings.HasPrefix(token, "ghp_"):
+		return "github-token"
+	case strings.HasPrefix(token, "sk_live_"), strings.HasPrefix(token, "pk_live_"):
+		return "stripe-key"
+	case strings.HasPrefix(token, "xoxb-"), strings.HasPrefix(token, "xoxp-"), strings.HasPrefix(token, "xoxa-"), strings.HasPrefix(token, "xoxr-"), strings.HasPrefix(token, "xoxs-"):
+		return "slack-token"
+	default:
+		return ""
+	}
+}
+
+// verifyToken performs a cheap live verification call for token shapes we
+// recognize, following the trufflehog verification model. It is only
+// invoked when SecretScanConfig.Verify is set explicitly, since it makes
+// outbound network calls using the candidate credential.
+func verifyToken(secretType, token string) bool {
+	client := &http.Client{Timeout: 5 * time.Second}
+
+	var req *http.Request
+	var err error
+
+	switch secretType {
+	case "github-token":
+		req, err = http.NewRequest(http.MethodGet, "https://api.github.com/user", nil)
+		if err == nil {
+			req.Header.Set("Authorization", fmt.Sprintf("token %s", token))
+		}
+	case "stripe-key":
+		req, err = http.NewRequest(http.MethodGet, "https://api.stripe.com/v1/account", nil)
+		if err == nil {
+			req.SetBasicAuth(token, "")
+		}
+	case "slack-token":
+		req, err = http.NewRequest(http.MethodGet, "https://slack.com/api/auth.test", nil)
+		if err == nil {
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		}
+	default:
+		return false
+	}
+	if err != nil || req == nil {
+		return false
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode == http.StatusOK
+}
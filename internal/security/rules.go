@@ -0,0 +1,242 @@
+package security
+
+import (
+	"bufio"
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/detective-cli/detective/pkg/models"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed rules/*.yaml
+var defaultRulesFS embed.FS
+
+// Rule is a single declarative detection rule, loadable from a YAML rule
+// pack. It generalizes the hard-coded secretPatterns/sqlInjectionPatterns/
+// xssPatterns maps into data so packs can be swapped without recompiling.
+type Rule struct {
+	ID          string   `yaml:"id"`
+	Description string   `yaml:"description"`
+	Severity    string   `yaml:"severity"`  // low|medium|high|critical
+	Languages   []string `yaml:"languages"` // file extensions this rule applies to; empty means all
+	Pattern     string   `yaml:"pattern"`
+	PatternNot  string   `yaml:"pattern-not"` // optional exclusion; a match here suppresses the finding
+	Category    string   `yaml:"category"`    // secret|sqli|xss|crypto|misc
+
+	compiled    *regexp.Regexp
+	compiledNot *regexp.Regexp
+	languages   map[string]bool
+}
+
+// RulePack is the top-level shape of a rule pack YAML file.
+type RulePack struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// RuleEngine compiles rule packs once and dispatches them per file based on
+// language filters, producing findings that flow into the existing
+// models.SecurityEvidence buckets.
+type RuleEngine struct {
+	rules []Rule
+}
+
+// NewRuleEngine builds an engine loaded with the bundled default rule pack.
+func NewRuleEngine() (*RuleEngine, error) {
+	engine := &RuleEngine{}
+	data, err := defaultRulesFS.ReadFile("rules/default.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("reading embedded default rule pack: %w", err)
+	}
+	if err := engine.loadPackData(data); err != nil {
+		return nil, fmt.Errorf("loading embedded default rule pack: %w", err)
+	}
+	return engine, nil
+}
+
+// LoadPack reads a user-supplied YAML rule pack from disk (--rules
+// path/to/pack.yaml) and appends its rules to the engine.
+func (e *RuleEngine) LoadPack(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading rule pack %s: %w", path, err)
+	}
+	if err := e.loadPackData(data); err != nil {
+		return fmt.Errorf("loading rule pack %s: %w", path, err)
+	}
+	return nil
+}
+
+func (e *RuleEngine) loadPackData(data []byte) error {
+	var pack RulePack
+	if err := yaml.Unmarshal(data, &pack); err != nil {
+		return err
+	}
+	for _, rule := range pack.Rules {
+		compiled, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return fmt.Errorf("rule %s: invalid pattern: %w", rule.ID, err)
+		}
+		rule.compiled = compiled
+
+		if rule.PatternNot != "" {
+			compiledNot, err := regexp.Compile(rule.PatternNot)
+			if err != nil {
+				return fmt.Errorf("rule %s: invalid pattern-not: %w", rule.ID, err)
+			}
+			rule.compiledNot = compiledNot
+		}
+
+		if len(rule.Languages) > 0 {
+			rule.languages = make(map[string]bool, len(rule.Languages))
+			for _, lang := range rule.Languages {
+				rule.languages[lang] = true
+			}
+		}
+
+		e.rules = append(e.rules, rule)
+	}
+	return nil
+}
+
+// Rules returns the compiled rules currently loaded, for inspection/testing.
+func (e *RuleEngine) Rules() []Rule {
+	return e.rules
+}
+
+// appliesTo reports whether a rule should run against a file with the given
+// extension. A rule with no Languages list applies to every extension.
+func (r Rule) appliesTo(ext string) bool {
+	if len(r.languages) == 0 {
+		return true
+	}
+	return r.languages[ext]
+}
+
+// ScanLine evaluates every rule that applies to ext against line and
+// returns the rules that matched.
+func (e *RuleEngine) ScanLine(ext, line string) []Rule {
+	var hits []Rule
+	for _, rule := range e.rules {
+		if !rule.appliesTo(ext) {
+			continue
+		}
+		if !rule.compiled.MatchString(line) {
+			continue
+		}
+		if rule.compiledNot != nil && rule.compiledNot.MatchString(line) {
+			continue
+		}
+		hits = append(hits, rule)
+	}
+	return hits
+}
+
+func ruleSeverity(s string) models.Severity {
+	switch s {
+	case "critical":
+		return models.SeverityCritical
+	case "high":
+		return models.SeverityHigh
+	case "medium":
+		return models.SeverityMedium
+	default:
+		return models.SeverityLow
+	}
+}
+
+// recordRuleHit appends a rule match into the SecurityEvidence bucket that
+// matches its category, keeping the RuleEngine additive to the existing
+// pattern-based scan rather than replacing it outright.
+func recordRuleHit(evidence *models.SecurityEvidence, rule Rule, path string, line int, text string) {
+	switch rule.Category {
+	case "secret":
+		evidence.HardcodedSecrets = append(evidence.HardcodedSecrets, models.SecretFinding{
+			File:    path,
+			Line:    line,
+			Type:    rule.ID,
+			Pattern: text,
+		})
+	case "sqli":
+		evidence.SQLInjectionRisks = append(evidence.SQLInjectionRisks, models.SecurityRisk{
+			File:        path,
+			Line:        line,
+			Type:        rule.ID,
+			Description: rule.Description,
+			Severity:    ruleSeverity(rule.Severity),
+		})
+	case "xss":
+		evidence.XSSRisks = append(evidence.XSSRisks, models.SecurityRisk{
+			File:        path,
+			Line:        line,
+			Type:        rule.ID,
+			Description: rule.Description,
+			Severity:    ruleSeverity(rule.Severity),
+		})
+	default: // crypto, misc, and anything else
+		evidence.InsecurePatterns = append(evidence.InsecurePatterns, models.SecurityRisk{
+			File:        path,
+			Line:        line,
+			Type:        rule.ID,
+			Description: rule.Description,
+			Severity:    ruleSeverity(rule.Severity),
+		})
+	}
+}
+
+// ScanSecurityWithRules walks rootPath and evaluates every rule in engine
+// against each source file, dispatching matches into a SecurityEvidence.
+// It is additive to ScanSecurityWithConfig: callers that have loaded a
+// custom or extended rule pack can merge the two evidence sets.
+func ScanSecurityWithRules(rootPath string, excludeDirs []string, engine *RuleEngine) (models.SecurityEvidence, error) {
+	evidence := models.SecurityEvidence{
+		HardcodedSecrets:  []models.SecretFinding{},
+		SQLInjectionRisks: []models.SecurityRisk{},
+		XSSRisks:          []models.SecurityRisk{},
+		InsecurePatterns:  []models.SecurityRisk{},
+	}
+
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		if info.IsDir() {
+			for _, exclude := range excludeDirs {
+				if info.Name() == exclude {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		ext := filepath.Ext(path)
+		if ext == "" && filepath.Base(path) != ".env" {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return nil
+		}
+		defer file.Close()
+
+		lineNum := 0
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			lineNum++
+			line := scanner.Text()
+			for _, rule := range engine.ScanLine(ext, line) {
+				recordRuleHit(&evidence, rule, path, lineNum, strings.TrimSpace(line))
+			}
+		}
+
+		return nil
+	})
+
+	return evidence, err
+}
@@ -0,0 +1,42 @@
+package security
+
+import "testing"
+
+func TestNewRuleEngineLoadsEmbeddedDefaultPack(t *testing.T) {
+	engine, err := NewRuleEngine()
+	if err != nil {
+		t.Fatalf("NewRuleEngine: %v", err)
+	}
+	if len(engine.Rules()) == 0 {
+		t.Fatal("NewRuleEngine loaded zero rules from the embedded default pack")
+	}
+}
+
+func TestPythonUnsafeYAMLLoadRule(t *testing.T) {
+	engine, err := NewRuleEngine()
+	if err != nil {
+		t.Fatalf("NewRuleEngine: %v", err)
+	}
+
+	hits := engine.ScanLine(".py", "data = yaml.load(f)")
+	if len(hits) != 1 || hits[0].ID != "python-unsafe-yaml-load" {
+		t.Errorf("ScanLine(yaml.load without SafeLoader) = %+v, want a single python-unsafe-yaml-load hit", hits)
+	}
+
+	hits = engine.ScanLine(".py", "data = yaml.load(f, Loader=yaml.SafeLoader)")
+	if len(hits) != 0 {
+		t.Errorf("ScanLine(yaml.load with SafeLoader) = %+v, want no hits", hits)
+	}
+}
+
+func TestScanLineAppliesLanguageFilter(t *testing.T) {
+	engine, err := NewRuleEngine()
+	if err != nil {
+		t.Fatalf("NewRuleEngine: %v", err)
+	}
+
+	hits := engine.ScanLine(".go", "data = yaml.load(f)")
+	if len(hits) != 0 {
+		t.Errorf("ScanLine(.go) matched a .py-only rule: %+v", hits)
+	}
+}
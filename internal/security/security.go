@@ -2,6 +2,7 @@ package security
 
 import (
 	"bufio"
+	"io"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -39,8 +40,21 @@ var xssPatterns = []*regexp.Regexp{
 	regexp.MustCompile(`(?i)dangerouslySetInnerHTML`),                // React unsafe pattern
 }
 
-// ScanSecurity performs security analysis on the codebase
+// ScanSecurity performs security analysis on the codebase using the
+// default entropy-based secret scan configuration.
 func ScanSecurity(rootPath string, excludeDirs []string) (models.SecurityEvidence, error) {
+	return ScanSecurityWithConfig(rootPath, excludeDirs, DefaultSecretScanConfig())
+}
+
+// ScanSecurityWithConfig performs security analysis on the codebase, in
+// addition flagging generic high-entropy secrets per cfg (gitleaks/
+// trufflehog style), honoring allowlists and the inline
+// `// detective:allow secret` ignore comment. When cfg.Verify is set,
+// recognized token shapes are checked against their issuing API and
+// annotated with SecretFinding.Verified.
+func ScanSecurityWithConfig(rootPath string, excludeDirs []string, cfg SecretScanConfig) (models.SecurityEvidence, error) {
+	cfg.compile()
+
 	evidence := models.SecurityEvidence{
 		HardcodedSecrets:  []models.SecretFinding{},
 		SQLInjectionRisks: []models.SecurityRisk{},
@@ -75,81 +89,128 @@ func ScanSecurity(rootPath string, excludeDirs []string) (models.SecurityEvidenc
 			return nil
 		}
 
-		// Scan file
+		if rel, relErr := filepath.Rel(rootPath, path); relErr == nil && cfg.isPathAllowlisted(rel) {
+			return nil
+		}
+
 		file, err := os.Open(path)
 		if err != nil {
 			return nil
 		}
 		defer file.Close()
 
-		scanner := bufio.NewScanner(file)
-		lineNum := 0
-		for scanner.Scan() {
-			lineNum++
-			line := scanner.Text()
-
-			// Check for hardcoded secrets
-			for secretType, pattern := range secretPatterns {
-				if pattern.MatchString(line) {
-					evidence.HardcodedSecrets = append(evidence.HardcodedSecrets, models.SecretFinding{
-						File:    path,
-						Line:    lineNum,
-						Type:    secretType,
-						Pattern: strings.TrimSpace(line),
-					})
-				}
-			}
+		MergeEvidence(&evidence, ScanFile(path, file, cfg))
+		return nil
+	})
 
-			// Check for SQL injection risks
-			for _, pattern := range sqlInjectionPatterns {
-				if pattern.MatchString(line) {
-					evidence.SQLInjectionRisks = append(evidence.SQLInjectionRisks, models.SecurityRisk{
-						File:        path,
-						Line:        lineNum,
-						Type:        "sql-injection",
-						Description: "Potential SQL injection vulnerability from string concatenation",
-						Severity:    models.SeverityHigh,
-					})
-				}
+	return evidence, err
+}
+
+var httpProtocolPattern = regexp.MustCompile(`(?i)http://`)
+
+// ScanFile runs every line-level security check (named secret patterns,
+// generic high-entropy secrets, SQLi/XSS regexes, and the misc insecure
+// patterns) against a single already-opened file and returns its findings.
+// It underlies both the serial ScanSecurityWithConfig walk and the
+// concurrent worker pool in pipeline.Scan, so the two stay in lockstep.
+func ScanFile(path string, r io.Reader, cfg SecretScanConfig) models.SecurityEvidence {
+	evidence := models.SecurityEvidence{
+		HardcodedSecrets:  []models.SecretFinding{},
+		SQLInjectionRisks: []models.SecurityRisk{},
+		XSSRisks:          []models.SecurityRisk{},
+		InsecurePatterns:  []models.SecurityRisk{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+
+		if inlineAllowComment.MatchString(line) {
+			continue
+		}
+
+		for secretType, pattern := range secretPatterns {
+			if pattern.MatchString(line) {
+				evidence.HardcodedSecrets = append(evidence.HardcodedSecrets, models.SecretFinding{
+					File:    path,
+					Line:    lineNum,
+					Type:    secretType,
+					Pattern: strings.TrimSpace(line),
+				})
 			}
+		}
 
-			// Check for XSS risks
-			for _, pattern := range xssPatterns {
-				if pattern.MatchString(line) {
-					evidence.XSSRisks = append(evidence.XSSRisks, models.SecurityRisk{
-						File:        path,
-						Line:        lineNum,
-						Type:        "xss",
-						Description: "Potential XSS vulnerability from unsafe HTML rendering",
-						Severity:    models.SeverityMedium,
-					})
-				}
+		for _, token := range scanLineForGenericSecrets(line, cfg) {
+			finding := models.SecretFinding{
+				File:    path,
+				Line:    lineNum,
+				Type:    "generic-secret",
+				Pattern: strings.TrimSpace(line),
+			}
+			if cfg.Verify {
+				finding.Verified = verifyToken(guessTokenType(token), token)
 			}
+			evidence.HardcodedSecrets = append(evidence.HardcodedSecrets, finding)
+		}
 
-			// Check for other insecure patterns
-			if strings.Contains(line, "crypto.MD5") || strings.Contains(line, "hashlib.md5") {
-				evidence.InsecurePatterns = append(evidence.InsecurePatterns, models.SecurityRisk{
+		for _, pattern := range sqlInjectionPatterns {
+			if pattern.MatchString(line) {
+				evidence.SQLInjectionRisks = append(evidence.SQLInjectionRisks, models.SecurityRisk{
 					File:        path,
 					Line:        lineNum,
-					Type:        "weak-crypto",
-					Description: "Weak hashing algorithm (MD5) detected",
-					Severity:    models.SeverityMedium,
+					Type:        "sql-injection",
+					Description: "Potential SQL injection vulnerability from string concatenation",
+					Severity:    models.SeverityHigh,
+					Confidence:  "low",
 				})
 			}
+		}
 
-			if regexp.MustCompile(`(?i)http://`).MatchString(line) && !strings.Contains(line, "localhost") {
-				evidence.InsecurePatterns = append(evidence.InsecurePatterns, models.SecurityRisk{
+		for _, pattern := range xssPatterns {
+			if pattern.MatchString(line) {
+				evidence.XSSRisks = append(evidence.XSSRisks, models.SecurityRisk{
 					File:        path,
 					Line:        lineNum,
-					Type:        "insecure-protocol",
-					Description: "Insecure HTTP protocol usage (should use HTTPS)",
-					Severity:    models.SeverityLow,
+					Type:        "xss",
+					Description: "Potential XSS vulnerability from unsafe HTML rendering",
+					Severity:    models.SeverityMedium,
+					Confidence:  "low",
 				})
 			}
 		}
 
-		return nil
-	})
+		if strings.Contains(line, "crypto.MD5") || strings.Contains(line, "hashlib.md5") {
+			evidence.InsecurePatterns = append(evidence.InsecurePatterns, models.SecurityRisk{
+				File:        path,
+				Line:        lineNum,
+				Type:        "weak-crypto",
+				Description: "Weak hashing algorithm (MD5) detected",
+				Severity:    models.SeverityMedium,
+			})
+		}
 
-	return evidence, err
+		if httpProtocolPattern.MatchString(line) && !strings.Contains(line, "localhost") {
+			evidence.InsecurePatterns = append(evidence.InsecurePatterns, models.SecurityRisk{
+				File:        path,
+				Line:        lineNum,
+				Type:        "insecure-protocol",
+				Description: "Insecure HTTP protocol usage (should use HTTPS)",
+				Severity:    models.SeverityLow,
+			})
+		}
+	}
+
+	return evidence
+}
+
+// MergeEvidence appends src's findings onto dst. Callers that scan a
+// codebase in pieces (per-file, per-rule-pack) use this to fold each piece's
+// results into one SecurityEvidence.
+func MergeEvidence(dst *models.SecurityEvidence, src models.SecurityEvidence) {
+	dst.HardcodedSecrets = append(dst.HardcodedSecrets, src.HardcodedSecrets...)
+	dst.SQLInjectionRisks = append(dst.SQLInjectionRisks, src.SQLInjectionRisks...)
+	dst.XSSRisks = append(dst.XSSRisks, src.XSSRisks...)
+	dst.InsecurePatterns = append(dst.InsecurePatterns, src.InsecurePatterns...)
 }
@@ -0,0 +1,206 @@
+package security
+
+import (
+	"bufio"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// AnalyzeTaint walks rootPath running AST-aware (for .go) and
+// tokenizer-based (for .js/.ts) taint analysis to reduce the false-positive
+// rate of the plain regex checks in ScanSecurity: a finding here is only
+// reported when the tainted value can be traced back to an untrusted
+// source, so every result carries Confidence "high".
+func AnalyzeTaint(rootPath string, excludeDirs []string) ([]models.SecurityRisk, error) {
+	var risks []models.SecurityRisk
+
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.IsDir() {
+			for _, exclude := range excludeDirs {
+				if info.Name() == exclude {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		switch filepath.Ext(path) {
+		case ".go":
+			risks = append(risks, analyzeGoTaint(path)...)
+		case ".js", ".ts", ".jsx", ".tsx":
+			risks = append(risks, analyzeJSTaint(path)...)
+		}
+		return nil
+	})
+
+	return risks, err
+}
+
+// dbCallNames are the sql.DB/sql.Tx methods that execute a raw query string.
+var dbCallNames = map[string]bool{
+	"Query": true, "QueryRow": true, "Exec": true,
+	"QueryContext": true, "QueryRowContext": true, "ExecContext": true,
+}
+
+// untrustedSelectors are method calls whose result is attacker-controlled
+// input in a typical net/http or gin handler.
+var untrustedSelectors = map[string]bool{
+	"FormValue": true, "PostFormValue": true, "PostForm": true, "Query": true,
+}
+
+// analyzeGoTaint parses a .go file and flags db.Query/db.Exec/db.QueryRow
+// calls whose first argument concatenates a value traceable to an HTTP
+// request.
+func analyzeGoTaint(path string) []models.SecurityRisk {
+	var risks []models.SecurityRisk
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.AllErrors)
+	if err != nil {
+		return nil
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !dbCallNames[sel.Sel.Name] {
+			return true
+		}
+		if len(call.Args) == 0 {
+			return true
+		}
+
+		bin, ok := call.Args[0].(*ast.BinaryExpr)
+		if !ok || bin.Op != token.ADD {
+			return true
+		}
+
+		if binExprTracesToUntrustedSource(bin) {
+			pos := fset.Position(call.Pos())
+			risks = append(risks, models.SecurityRisk{
+				File:        path,
+				Line:        pos.Line,
+				Type:        "sql-injection",
+				Description: "Query built via string concatenation with a value traced to HTTP request input",
+				Severity:    models.SeverityHigh,
+				Confidence:  "high",
+			})
+		}
+		return true
+	})
+
+	return risks
+}
+
+// binExprTracesToUntrustedSource recursively inspects a `+`-concatenation
+// expression for an operand that reads from an HTTP request: a call like
+// r.FormValue(...)/c.Query(...), or a selector chain rooted at .URL.Query
+// or .PostForm.
+func binExprTracesToUntrustedSource(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BinaryExpr:
+		return binExprTracesToUntrustedSource(e.X) || binExprTracesToUntrustedSource(e.Y)
+	case *ast.CallExpr:
+		if sel, ok := e.Fun.(*ast.SelectorExpr); ok {
+			if untrustedSelectors[sel.Sel.Name] {
+				return true
+			}
+			return binExprTracesToUntrustedSource(sel.X)
+		}
+		return false
+	case *ast.SelectorExpr:
+		if e.Sel.Name == "Query" || e.Sel.Name == "PostForm" {
+			return true
+		}
+		return binExprTracesToUntrustedSource(e.X)
+	case *ast.ParenExpr:
+		return binExprTracesToUntrustedSource(e.X)
+	default:
+		return false
+	}
+}
+
+// jsSinkPatterns are the DOM/JS sinks worth flagging when their argument is
+// tainted.
+var jsSinkPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)innerHTML\s*=\s*(.+)$`),
+	regexp.MustCompile(`(?i)eval\s*\((.+)\)`),
+	regexp.MustCompile(`(?i)document\.write\s*\((.+)\)`),
+}
+
+// jsTaintSourceRE matches the well-known Express-style request accessors.
+var jsTaintSourceRE = regexp.MustCompile(`\breq\.(body|query|params)\b`)
+
+// jsAssignmentRE captures `var|let|const name = <rhs>` so a variable
+// assigned from a tainted expression can be tracked to later uses
+// (simple intraprocedural assignment tracking, not full dataflow).
+var jsAssignmentRE = regexp.MustCompile(`(?:var|let|const)\s+([A-Za-z_$][\w$]*)\s*=\s*(.+)$`)
+
+// analyzeJSTaint tokenizes a .js/.ts file line by line, tracking variables
+// assigned from req.body/req.query/req.params and flagging sinks whose
+// argument references the request directly or through a tracked variable.
+func analyzeJSTaint(path string) []models.SecurityRisk {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	tainted := map[string]bool{}
+	var risks []models.SecurityRisk
+
+	scanner := bufio.NewScanner(f)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+
+		if m := jsAssignmentRE.FindStringSubmatch(line); m != nil {
+			name, rhs := m[1], m[2]
+			if jsTaintSourceRE.MatchString(rhs) || referencesTaintedVar(rhs, tainted) {
+				tainted[name] = true
+			}
+		}
+
+		for _, sink := range jsSinkPatterns {
+			m := sink.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			arg := m[1]
+			if jsTaintSourceRE.MatchString(arg) || referencesTaintedVar(arg, tainted) {
+				risks = append(risks, models.SecurityRisk{
+					File:        path,
+					Line:        lineNum,
+					Type:        "xss",
+					Description: "Unsafe sink reached by a value traced to req.body/req.query/req.params",
+					Severity:    models.SeverityHigh,
+					Confidence:  "high",
+				})
+			}
+		}
+	}
+
+	return risks
+}
+
+func referencesTaintedVar(expr string, tainted map[string]bool) bool {
+	for name := range tainted {
+		if regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\b`).MatchString(expr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package security
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTempFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("writing %s: %v", name, err)
+	}
+	return path
+}
+
+func TestAnalyzeGoTaintFlagsRequestTracedConcatenation(t *testing.T) {
+	src := `package main
+
+import "net/http"
+
+func handler(w http.ResponseWriter, r *http.Request) {
+	db.Query("SELECT * FROM users WHERE name = '" + r.FormValue("name") + "'")
+}
+`
+	path := writeTempFile(t, "handler.go", src)
+
+	risks := analyzeGoTaint(path)
+	if len(risks) != 1 {
+		t.Fatalf("got %d risks, want 1: %+v", len(risks), risks)
+	}
+	if risks[0].Type != "sql-injection" || risks[0].Confidence != "high" {
+		t.Errorf("risk = %+v, want sql-injection/high", risks[0])
+	}
+}
+
+func TestAnalyzeGoTaintIgnoresConstantQueries(t *testing.T) {
+	src := `package main
+
+func query() {
+	db.Query("SELECT * FROM users WHERE id = ?", 1)
+}
+`
+	path := writeTempFile(t, "safe.go", src)
+
+	risks := analyzeGoTaint(path)
+	if len(risks) != 0 {
+		t.Errorf("got %d risks for a parameterized query, want 0: %+v", len(risks), risks)
+	}
+}
+
+func TestAnalyzeGoTaintIgnoresConcatenationWithoutUntrustedSource(t *testing.T) {
+	src := `package main
+
+func query(table string) {
+	db.Query("SELECT * FROM " + table)
+}
+`
+	path := writeTempFile(t, "tableconcat.go", src)
+
+	risks := analyzeGoTaint(path)
+	if len(risks) != 0 {
+		t.Errorf("got %d risks for concatenation not traced to a request, want 0: %+v", len(risks), risks)
+	}
+}
+
+func TestAnalyzeJSTaintFlagsDirectSinkUse(t *testing.T) {
+	src := `app.post('/greet', (req, res) => {
+	el.innerHTML = req.body.name;
+});
+`
+	path := writeTempFile(t, "handler.js", src)
+
+	risks := analyzeJSTaint(path)
+	if len(risks) != 1 {
+		t.Fatalf("got %d risks, want 1: %+v", len(risks), risks)
+	}
+	if risks[0].Type != "xss" || risks[0].Confidence != "high" {
+		t.Errorf("risk = %+v, want xss/high", risks[0])
+	}
+}
+
+func TestAnalyzeJSTaintTracksAssignedVariable(t *testing.T) {
+	src := `app.post('/greet', (req, res) => {
+	const name = req.query.name;
+	document.write(name);
+});
+`
+	path := writeTempFile(t, "handler2.js", src)
+
+	risks := analyzeJSTaint(path)
+	if len(risks) != 1 {
+		t.Fatalf("got %d risks, want 1 (tainted var traced to sink): %+v", len(risks), risks)
+	}
+}
+
+func TestAnalyzeJSTaintIgnoresUntaintedSink(t *testing.T) {
+	src := `const greeting = "hello";
+el.innerHTML = greeting;
+`
+	path := writeTempFile(t, "safe.js", src)
+
+	risks := analyzeJSTaint(path)
+	if len(risks) != 0 {
+		t.Errorf("got %d risks for an untainted sink argument, want 0: %+v", len(risks), risks)
+	}
+}
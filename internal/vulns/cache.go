@@ -0,0 +1,80 @@
+package vulns
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+const osvCacheFileName = "osv-cache.json"
+
+// OSVCache is a JSON-backed cache of OSV.dev batch query results, keyed by
+// "ecosystem|name|version" rather than path/mtime the way
+// internal/scanner.FileCache is: a declared dependency's resolved
+// vulnerabilities only grow as new advisories are published, so a cache hit
+// here is a deliberate tradeoff of freshness for offline use and avoiding
+// redundant network calls on repeat scans, not a correctness guarantee the
+// way a commit's immutable stats are in internal/git.CommitStatsCache.
+// Callers that want up-to-date results should pass noCache/rebuild like the
+// other local caches under .detective.
+type OSVCache struct {
+	mu      sync.Mutex
+	entries map[string][]models.DependencyFinding
+}
+
+// LoadOSVCache reads dir/osv-cache.json, returning an empty cache if it
+// does not exist yet or fails to parse.
+func LoadOSVCache(dir string) *OSVCache {
+	c := &OSVCache{entries: make(map[string][]models.DependencyFinding)}
+
+	data, err := os.ReadFile(filepath.Join(dir, osvCacheFileName))
+	if err != nil {
+		return c
+	}
+	if err := json.Unmarshal(data, &c.entries); err != nil {
+		c.entries = make(map[string][]models.DependencyFinding)
+	}
+	return c
+}
+
+// packageKey is the cache key for a single dependency version.
+func packageKey(pkg Package) string {
+	return pkg.Ecosystem + "|" + pkg.Name + "|" + pkg.Version
+}
+
+// Get returns the cached findings for pkg, if any.
+func (c *OSVCache) Get(pkg Package) ([]models.DependencyFinding, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	findings, ok := c.entries[packageKey(pkg)]
+	return findings, ok
+}
+
+// Put records the findings OSV.dev reported for pkg, which may be an empty
+// (but non-nil) slice to record a clean lookup.
+func (c *OSVCache) Put(pkg Package, findings []models.DependencyFinding) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if findings == nil {
+		findings = []models.DependencyFinding{}
+	}
+	c.entries[packageKey(pkg)] = findings
+}
+
+// Save writes the cache to dir/osv-cache.json, creating dir if needed.
+func (c *OSVCache) Save(dir string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(c.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, osvCacheFileName), data, 0o644)
+}
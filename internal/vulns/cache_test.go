@@ -0,0 +1,66 @@
+package vulns
+
+import (
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestOSVCacheGetPutRoundTrip(t *testing.T) {
+	c := LoadOSVCache(t.TempDir())
+	pkg := Package{Ecosystem: "npm", Name: "lodash", Version: "4.17.20"}
+
+	if _, ok := c.Get(pkg); ok {
+		t.Fatalf("Get on empty cache returned ok=true")
+	}
+
+	want := []models.DependencyFinding{{Ecosystem: "npm", Name: "lodash", Version: "4.17.20", VulnerabilityID: "GHSA-xxxx"}}
+	c.Put(pkg, want)
+
+	got, ok := c.Get(pkg)
+	if !ok {
+		t.Fatalf("Get after Put returned ok=false")
+	}
+	if len(got) != 1 || got[0].VulnerabilityID != "GHSA-xxxx" {
+		t.Errorf("Get = %+v, want %+v", got, want)
+	}
+}
+
+func TestOSVCacheSaveLoadRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	pkg := Package{Ecosystem: "Go", Name: "github.com/pkg/errors", Version: "v0.9.1"}
+
+	c := LoadOSVCache(dir)
+	c.Put(pkg, []models.DependencyFinding{{VulnerabilityID: "CVE-2021-0001"}})
+	if err := c.Save(dir); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	reloaded := LoadOSVCache(dir)
+	got, ok := reloaded.Get(pkg)
+	if !ok || len(got) != 1 || got[0].VulnerabilityID != "CVE-2021-0001" {
+		t.Errorf("reloaded Get = %+v, ok=%v, want a single CVE-2021-0001 finding", got, ok)
+	}
+}
+
+func TestOSVCachePutNilFindingsRecordsCleanLookup(t *testing.T) {
+	c := LoadOSVCache(t.TempDir())
+	pkg := Package{Ecosystem: "npm", Name: "left-pad", Version: "1.3.0"}
+
+	c.Put(pkg, nil)
+
+	got, ok := c.Get(pkg)
+	if !ok {
+		t.Fatalf("Get after Put(nil) returned ok=false, want a cached empty slice")
+	}
+	if len(got) != 0 {
+		t.Errorf("got = %+v, want empty", got)
+	}
+}
+
+func TestLoadOSVCacheMissingFileReturnsEmptyCache(t *testing.T) {
+	c := LoadOSVCache(t.TempDir())
+	if _, ok := c.Get(Package{Ecosystem: "npm", Name: "anything", Version: "1.0.0"}); ok {
+		t.Errorf("Get on a freshly loaded empty cache returned ok=true")
+	}
+}
@@ -0,0 +1,325 @@
+// Package vulns complements the source-level checks in internal/security by
+// scanning declared dependencies and Dockerfiles for known issues: published
+// vulnerabilities via the OSV.dev batch API, and container misconfigurations
+// like unpinned base images.
+package vulns
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Package identifies a single declared dependency for advisory lookup.
+type Package struct {
+	Ecosystem string // OSV.dev ecosystem name: "npm", "Go", "PyPI", "RubyGems", "Packagist"
+	Name      string
+	Version   string
+}
+
+// lockFileParsers maps a lockfile's base name to the parser that
+// understands its format.
+var lockFileParsers = map[string]func(data []byte) ([]Package, error){
+	"package-lock.json": parsePackageLockJSON,
+	"yarn.lock":         parseYarnLock,
+	"go.sum":            parseGoSum,
+	"requirements.txt":  parseRequirementsTxt,
+	"Pipfile.lock":      parsePipfileLock,
+	"Gemfile.lock":      parseGemfileLock,
+	"composer.lock":     parseComposerLock,
+	"Cargo.lock":        parseCargoLock,
+	"pom.xml":           parsePomXML,
+}
+
+// ParseDependencies walks rootPath for recognized lockfiles and returns the
+// union of packages they declare. A project with multiple lockfiles (e.g.
+// both go.sum and package-lock.json) contributes packages from each.
+func ParseDependencies(rootPath string, excludeDirs []string) ([]Package, error) {
+	var packages []Package
+
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		if info.IsDir() {
+			for _, exclude := range excludeDirs {
+				if info.Name() == exclude || strings.HasPrefix(info.Name(), ".") {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		parse, ok := lockFileParsers[info.Name()]
+		if !ok {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+
+		pkgs, err := parse(data)
+		if err != nil {
+			return nil
+		}
+		packages = append(packages, pkgs...)
+		return nil
+	})
+
+	return packages, err
+}
+
+func parsePackageLockJSON(data []byte) ([]Package, error) {
+	var doc struct {
+		Packages map[string]struct {
+			Version string `json:"version"`
+		} `json:"packages"`
+		Dependencies map[string]struct {
+			Version string `json:"version"`
+		} `json:"dependencies"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	var packages []Package
+	for name, pkg := range doc.Packages {
+		name = strings.TrimPrefix(name, "node_modules/")
+		if name == "" || pkg.Version == "" {
+			continue
+		}
+		packages = append(packages, Package{Ecosystem: "npm", Name: name, Version: pkg.Version})
+	}
+	for name, pkg := range doc.Dependencies {
+		if pkg.Version == "" {
+			continue
+		}
+		packages = append(packages, Package{Ecosystem: "npm", Name: name, Version: pkg.Version})
+	}
+	return packages, nil
+}
+
+var (
+	yarnHeaderRE  = regexp.MustCompile(`^"?([^@"][^@,]*)@`)
+	yarnVersionRE = regexp.MustCompile(`^\s+version\s+"([^"]+)"`)
+)
+
+// parseYarnLock reads the classic yarn.lock block format:
+//
+//	"pkg-name@^1.0.0", "pkg-name@~1.0.0":
+//	  version "1.0.1"
+//	  ...
+func parseYarnLock(data []byte) ([]Package, error) {
+	var packages []Package
+	var pendingName string
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasSuffix(strings.TrimSpace(line), ":") && !strings.HasPrefix(line, " ") {
+			header := strings.SplitN(line, ",", 2)[0]
+			if m := yarnHeaderRE.FindStringSubmatch(header); m != nil {
+				pendingName = strings.Trim(m[1], `"`)
+			}
+			continue
+		}
+
+		if m := yarnVersionRE.FindStringSubmatch(line); m != nil && pendingName != "" {
+			packages = append(packages, Package{Ecosystem: "npm", Name: pendingName, Version: m[1]})
+			pendingName = ""
+		}
+	}
+
+	return packages, scanner.Err()
+}
+
+// parseGoSum reads go.sum's `module version hash` lines, skipping the
+// paired `/go.mod` hash entries so each module@version appears once.
+func parseGoSum(data []byte) ([]Package, error) {
+	var packages []Package
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		module, version := fields[0], fields[1]
+		if strings.HasSuffix(version, "/go.mod") {
+			continue
+		}
+		packages = append(packages, Package{Ecosystem: "Go", Name: module, Version: version})
+	}
+
+	return packages, scanner.Err()
+}
+
+// parseRequirementsTxt reads pip's `name==version` pins, ignoring comments,
+// blank lines, and option flags (-r, --hash, etc.).
+func parseRequirementsTxt(data []byte) ([]Package, error) {
+	var packages []Package
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "-") {
+			continue
+		}
+		parts := strings.SplitN(line, "==", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		name := strings.TrimSpace(parts[0])
+		version := strings.TrimSpace(strings.SplitN(parts[1], ";", 2)[0])
+		if name == "" || version == "" {
+			continue
+		}
+		packages = append(packages, Package{Ecosystem: "PyPI", Name: name, Version: version})
+	}
+
+	return packages, scanner.Err()
+}
+
+func parsePipfileLock(data []byte) ([]Package, error) {
+	var doc struct {
+		Default map[string]struct {
+			Version string `json:"version"`
+		} `json:"default"`
+		Develop map[string]struct {
+			Version string `json:"version"`
+		} `json:"develop"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	var packages []Package
+	for name, pkg := range doc.Default {
+		packages = append(packages, Package{Ecosystem: "PyPI", Name: name, Version: strings.TrimPrefix(pkg.Version, "==")})
+	}
+	for name, pkg := range doc.Develop {
+		packages = append(packages, Package{Ecosystem: "PyPI", Name: name, Version: strings.TrimPrefix(pkg.Version, "==")})
+	}
+	return packages, nil
+}
+
+var gemSpecRE = regexp.MustCompile(`^\s{4}([A-Za-z0-9_.\-]+)\s+\(([^)]+)\)`)
+
+// parseGemfileLock reads the `GEM` section's `    name (version)` spec lines.
+func parseGemfileLock(data []byte) ([]Package, error) {
+	var packages []Package
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		if m := gemSpecRE.FindStringSubmatch(scanner.Text()); m != nil {
+			packages = append(packages, Package{Ecosystem: "RubyGems", Name: m[1], Version: m[2]})
+		}
+	}
+
+	return packages, scanner.Err()
+}
+
+// parseCargoLock reads Cargo.lock's `[[package]]` stanzas:
+//
+//	[[package]]
+//	name = "serde"
+//	version = "1.0.190"
+//	...
+//
+// This is a line-oriented reading of the subset of TOML Cargo.lock actually
+// uses rather than a general TOML parser, matching how parseGemfileLock and
+// parseYarnLock handle their own lockfile formats.
+func parseCargoLock(data []byte) ([]Package, error) {
+	var packages []Package
+	var pendingName string
+	inPackage := false
+
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "[[package]]" {
+			inPackage = true
+			pendingName = ""
+			continue
+		}
+		if !inPackage {
+			continue
+		}
+		if strings.HasPrefix(line, "name = ") {
+			pendingName = strings.Trim(strings.TrimPrefix(line, "name = "), `"`)
+			continue
+		}
+		if strings.HasPrefix(line, "version = ") && pendingName != "" {
+			version := strings.Trim(strings.TrimPrefix(line, "version = "), `"`)
+			packages = append(packages, Package{Ecosystem: "crates.io", Name: pendingName, Version: version})
+			inPackage = false
+		}
+	}
+
+	return packages, scanner.Err()
+}
+
+// parsePomXML reads a Maven pom.xml's declared <dependencies>, skipping any
+// dependency that omits a literal version (e.g. one managed by a parent POM
+// or a property placeholder) since there's no version to look up.
+func parsePomXML(data []byte) ([]Package, error) {
+	var doc struct {
+		Dependencies struct {
+			Dependency []struct {
+				GroupID    string `xml:"groupId"`
+				ArtifactID string `xml:"artifactId"`
+				Version    string `xml:"version"`
+			} `xml:"dependency"`
+		} `xml:"dependencies"`
+	}
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	var packages []Package
+	for _, dep := range doc.Dependencies.Dependency {
+		if dep.Version == "" || strings.Contains(dep.Version, "${") {
+			continue
+		}
+		packages = append(packages, Package{
+			Ecosystem: "Maven",
+			Name:      dep.GroupID + ":" + dep.ArtifactID,
+			Version:   dep.Version,
+		})
+	}
+	return packages, nil
+}
+
+func parseComposerLock(data []byte) ([]Package, error) {
+	var doc struct {
+		Packages []struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"packages"`
+		PackagesDev []struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"packages-dev"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	var packages []Package
+	for _, pkg := range doc.Packages {
+		packages = append(packages, Package{Ecosystem: "Packagist", Name: pkg.Name, Version: pkg.Version})
+	}
+	for _, pkg := range doc.PackagesDev {
+		packages = append(packages, Package{Ecosystem: "Packagist", Name: pkg.Name, Version: pkg.Version})
+	}
+	return packages, nil
+}
@@ -0,0 +1,198 @@
+package vulns
+
+import "testing"
+
+func TestParsePackageLockJSON(t *testing.T) {
+	data := []byte(`{
+		"packages": {
+			"": {"version": "1.0.0"},
+			"node_modules/lodash": {"version": "4.17.21"}
+		},
+		"dependencies": {
+			"left-pad": {"version": "1.3.0"}
+		}
+	}`)
+
+	got, err := parsePackageLockJSON(data)
+	if err != nil {
+		t.Fatalf("parsePackageLockJSON: %v", err)
+	}
+
+	want := map[string]string{"lodash": "4.17.21", "left-pad": "1.3.0"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d packages, want %d: %+v", len(got), len(want), got)
+	}
+	for _, pkg := range got {
+		if pkg.Ecosystem != "npm" {
+			t.Errorf("package %s: ecosystem = %q, want npm", pkg.Name, pkg.Ecosystem)
+		}
+		if v, ok := want[pkg.Name]; !ok || v != pkg.Version {
+			t.Errorf("unexpected package %+v", pkg)
+		}
+	}
+}
+
+func TestParseYarnLock(t *testing.T) {
+	data := []byte(`# yarn lockfile v1
+
+"lodash@^4.17.0", "lodash@~4.17.21":
+  version "4.17.21"
+  resolved "https://registry.yarnpkg.com/lodash/-/lodash-4.17.21.tgz"
+
+left-pad@^1.3.0:
+  version "1.3.0"
+`)
+
+	got, err := parseYarnLock(data)
+	if err != nil {
+		t.Fatalf("parseYarnLock: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d packages, want 2: %+v", len(got), got)
+	}
+	if got[0].Name != "lodash" || got[0].Version != "4.17.21" {
+		t.Errorf("got[0] = %+v, want lodash@4.17.21", got[0])
+	}
+	if got[1].Name != "left-pad" || got[1].Version != "1.3.0" {
+		t.Errorf("got[1] = %+v, want left-pad@1.3.0", got[1])
+	}
+}
+
+func TestParseGoSum(t *testing.T) {
+	data := []byte(`github.com/pkg/errors v0.9.1 h1:FEBLx1zS214owpjy7qsBeixbURkuhQAwrK5UwLGTwt4=
+github.com/pkg/errors v0.9.1/go.mod h1:bwawxfHBFNV+L2hUp1rHADufV3IMtnDRdf1r5NINEl0=
+`)
+
+	got, err := parseGoSum(data)
+	if err != nil {
+		t.Fatalf("parseGoSum: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("got %d packages, want 1 (go.mod hash line should be skipped): %+v", len(got), got)
+	}
+	if got[0].Ecosystem != "Go" || got[0].Name != "github.com/pkg/errors" || got[0].Version != "v0.9.1" {
+		t.Errorf("got[0] = %+v", got[0])
+	}
+}
+
+func TestParseRequirementsTxt(t *testing.T) {
+	data := []byte(`# comment
+-r base.txt
+requests==2.31.0
+flask==2.3.2; python_version >= "3.8"
+
+django>=4.0
+`)
+
+	got, err := parseRequirementsTxt(data)
+	if err != nil {
+		t.Fatalf("parseRequirementsTxt: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d packages, want 2 (comment/-r/unpinned lines skipped): %+v", len(got), got)
+	}
+	if got[0].Name != "requests" || got[0].Version != "2.31.0" {
+		t.Errorf("got[0] = %+v", got[0])
+	}
+	if got[1].Name != "flask" || got[1].Version != "2.3.2" {
+		t.Errorf("got[1] = %+v", got[1])
+	}
+}
+
+func TestParseGemfileLock(t *testing.T) {
+	data := []byte(`GEM
+  remote: https://rubygems.org/
+  specs:
+    rack (2.2.8)
+    rack-test (2.1.0)
+      rack (>= 1.0, < 3)
+
+PLATFORMS
+  ruby
+`)
+
+	got, err := parseGemfileLock(data)
+	if err != nil {
+		t.Fatalf("parseGemfileLock: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d packages, want 2: %+v", len(got), got)
+	}
+	if got[0].Name != "rack" || got[0].Version != "2.2.8" {
+		t.Errorf("got[0] = %+v", got[0])
+	}
+}
+
+func TestParseCargoLock(t *testing.T) {
+	data := []byte(`# This file is automatically @generated by Cargo.
+
+[[package]]
+name = "serde"
+version = "1.0.190"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+
+[[package]]
+name = "libc"
+version = "0.2.149"
+`)
+
+	got, err := parseCargoLock(data)
+	if err != nil {
+		t.Fatalf("parseCargoLock: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d packages, want 2: %+v", len(got), got)
+	}
+	if got[0].Ecosystem != "crates.io" || got[0].Name != "serde" || got[0].Version != "1.0.190" {
+		t.Errorf("got[0] = %+v", got[0])
+	}
+	if got[1].Name != "libc" || got[1].Version != "0.2.149" {
+		t.Errorf("got[1] = %+v", got[1])
+	}
+}
+
+func TestParsePomXML(t *testing.T) {
+	data := []byte(`<project>
+  <dependencies>
+    <dependency>
+      <groupId>org.springframework</groupId>
+      <artifactId>spring-core</artifactId>
+      <version>5.3.27</version>
+    </dependency>
+    <dependency>
+      <groupId>com.example</groupId>
+      <artifactId>managed-by-parent</artifactId>
+      <version>${parent.version}</version>
+    </dependency>
+  </dependencies>
+</project>`)
+
+	got, err := parsePomXML(data)
+	if err != nil {
+		t.Fatalf("parsePomXML: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("got %d packages, want 1 (property-versioned dep skipped): %+v", len(got), got)
+	}
+	if got[0].Ecosystem != "Maven" || got[0].Name != "org.springframework:spring-core" || got[0].Version != "5.3.27" {
+		t.Errorf("got[0] = %+v", got[0])
+	}
+}
+
+func TestParseComposerLock(t *testing.T) {
+	data := []byte(`{
+		"packages": [{"name": "monolog/monolog", "version": "2.9.1"}],
+		"packages-dev": [{"name": "phpunit/phpunit", "version": "9.6.10"}]
+	}`)
+
+	got, err := parseComposerLock(data)
+	if err != nil {
+		t.Fatalf("parseComposerLock: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d packages, want 2: %+v", len(got), got)
+	}
+	if got[0].Ecosystem != "Packagist" {
+		t.Errorf("got[0].Ecosystem = %q, want Packagist", got[0].Ecosystem)
+	}
+}
@@ -0,0 +1,119 @@
+package vulns
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+var (
+	fromLineRE = regexp.MustCompile(`(?i)^FROM\s+(\S+)`)
+	userLineRE = regexp.MustCompile(`(?i)^USER\s+(\S+)`)
+	addLineRE  = regexp.MustCompile(`(?i)^ADD\s+(\S+)`)
+)
+
+// ScanDockerfiles walks rootPath and flags container build practices that
+// weaken reproducibility or harden the resulting image: an unpinned base
+// image tag, a root USER, and ADD pulling from a remote URL instead of
+// COPY. It extends detector.GetFrameworkSpecificAdvice's generic "Docker
+// detected" advice with findings anchored to a specific file and line.
+func ScanDockerfiles(rootPath string, excludeDirs []string) ([]models.SecurityRisk, error) {
+	var risks []models.SecurityRisk
+
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		if info.IsDir() {
+			for _, exclude := range excludeDirs {
+				if info.Name() == exclude || strings.HasPrefix(info.Name(), ".") {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		if !strings.HasPrefix(info.Name(), "Dockerfile") {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return nil
+		}
+		defer file.Close()
+
+		risks = append(risks, scanDockerfile(path, file)...)
+		return nil
+	})
+
+	return risks, err
+}
+
+func scanDockerfile(path string, f *os.File) []models.SecurityRisk {
+	var risks []models.SecurityRisk
+
+	scanner := bufio.NewScanner(f)
+	lineNum := 0
+	sawUser := false
+
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+
+		if m := fromLineRE.FindStringSubmatch(line); m != nil {
+			image := m[1]
+			if !strings.Contains(image, "@") && (!strings.Contains(image, ":") || strings.HasSuffix(image, ":latest")) {
+				risks = append(risks, models.SecurityRisk{
+					File:        path,
+					Line:        lineNum,
+					Type:        "unpinned-base-image",
+					Description: "Base image '" + image + "' has no pinned tag or digest, so builds are not reproducible",
+					Severity:    models.SeverityMedium,
+				})
+			}
+		}
+
+		if m := userLineRE.FindStringSubmatch(line); m != nil {
+			sawUser = true
+			if user := m[1]; user == "root" || user == "0" {
+				risks = append(risks, models.SecurityRisk{
+					File:        path,
+					Line:        lineNum,
+					Type:        "root-container-user",
+					Description: "Container explicitly runs as root",
+					Severity:    models.SeverityMedium,
+				})
+			}
+		}
+
+		if m := addLineRE.FindStringSubmatch(line); m != nil {
+			if src := m[1]; strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://") {
+				risks = append(risks, models.SecurityRisk{
+					File:        path,
+					Line:        lineNum,
+					Type:        "add-remote-url",
+					Description: "ADD fetches a remote URL; prefer COPY with a vendored or verified artifact",
+					Severity:    models.SeverityLow,
+				})
+			}
+		}
+	}
+
+	if !sawUser {
+		risks = append(risks, models.SecurityRisk{
+			File:        path,
+			Line:        1,
+			Type:        "no-user-instruction",
+			Description: "Dockerfile never switches away from the default root user",
+			Severity:    models.SeverityLow,
+		})
+	}
+
+	return risks
+}
@@ -0,0 +1,99 @@
+package vulns
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func mustTempFile(t *testing.T, content string) *os.File {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "Dockerfile")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("writing temp Dockerfile: %v", err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening temp Dockerfile: %v", err)
+	}
+	return f
+}
+
+func TestScanDockerfileUnpinnedRootUser(t *testing.T) {
+	content := `FROM ubuntu:latest
+RUN apt-get update
+ADD https://example.com/install.sh /tmp/install.sh
+USER root
+`
+	f := mustTempFile(t, content)
+	defer f.Close()
+
+	risks := scanDockerfile("Dockerfile", f)
+
+	var kinds []string
+	for _, r := range risks {
+		kinds = append(kinds, r.Type)
+	}
+	joined := strings.Join(kinds, ",")
+
+	for _, want := range []string{"unpinned-base-image", "root-container-user", "add-remote-url"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("risks = %v, want to contain %q", kinds, want)
+		}
+	}
+	if strings.Contains(joined, "no-user-instruction") {
+		t.Errorf("risks = %v, should not flag no-user-instruction when USER is present", kinds)
+	}
+}
+
+func TestScanDockerfilePinnedNonRootUser(t *testing.T) {
+	content := `FROM golang:1.21.6-bookworm
+COPY . /app
+USER 1000
+`
+	f := mustTempFile(t, content)
+	defer f.Close()
+
+	risks := scanDockerfile("Dockerfile", f)
+	for _, r := range risks {
+		if r.Type == "unpinned-base-image" || r.Type == "root-container-user" || r.Type == "no-user-instruction" {
+			t.Errorf("unexpected risk %+v for a pinned, non-root Dockerfile", r)
+		}
+	}
+}
+
+func TestScanDockerfileMissingUserInstruction(t *testing.T) {
+	content := `FROM golang:1.21.6-bookworm
+COPY . /app
+`
+	f := mustTempFile(t, content)
+	defer f.Close()
+
+	risks := scanDockerfile("Dockerfile", f)
+
+	found := false
+	for _, r := range risks {
+		if r.Type == "no-user-instruction" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("risks = %+v, want a no-user-instruction finding", risks)
+	}
+}
+
+func TestScanDockerfileDigestPinnedImageNotFlagged(t *testing.T) {
+	content := `FROM golang@sha256:1234567890abcdef1234567890abcdef1234567890abcdef1234567890abcd
+USER 1000
+`
+	f := mustTempFile(t, content)
+	defer f.Close()
+
+	risks := scanDockerfile("Dockerfile", f)
+	for _, r := range risks {
+		if r.Type == "unpinned-base-image" {
+			t.Errorf("unexpected unpinned-base-image finding for a digest-pinned image: %+v", r)
+		}
+	}
+}
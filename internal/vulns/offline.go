@@ -0,0 +1,89 @@
+package vulns
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// QueryOSVOffline matches packages against a pre-downloaded OSV.dev dump
+// directory instead of calling the live API, for environments without
+// network access to osv.dev. dumpDir is expected to follow OSV's own
+// distribution layout: one subdirectory per ecosystem (e.g. "npm", "PyPI"),
+// each holding one <vuln-id>.json record per advisory, exactly as produced
+// by unzipping https://osv-vulnerabilities.storage.googleapis.com/<ecosystem>/all.zip.
+// Unlike the live querybatch API, those records carry the affected
+// package's identity and exact version list alongside each advisory, so
+// matching happens locally instead of via a network round trip.
+func QueryOSVOffline(dumpDir string, packages []Package) ([]models.DependencyFinding, error) {
+	byEcosystem := make(map[string][]Package)
+	for _, pkg := range packages {
+		byEcosystem[pkg.Ecosystem] = append(byEcosystem[pkg.Ecosystem], pkg)
+	}
+
+	var findings []models.DependencyFinding
+	for ecosystem, pkgs := range byEcosystem {
+		entries, err := os.ReadDir(filepath.Join(dumpDir, ecosystem))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+				continue
+			}
+			record, err := readOfflineRecord(filepath.Join(dumpDir, ecosystem, entry.Name()))
+			if err != nil {
+				continue
+			}
+			findings = append(findings, matchOfflineRecord(record, pkgs)...)
+		}
+	}
+
+	return findings, nil
+}
+
+func readOfflineRecord(path string) (osvRecord, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return osvRecord{}, err
+	}
+	var record osvRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return osvRecord{}, err
+	}
+	return record, nil
+}
+
+// matchOfflineRecord returns one DependencyFinding per package that record
+// declares as affected, by exact version match against the affected
+// entry's declared Versions list.
+func matchOfflineRecord(record osvRecord, pkgs []Package) []models.DependencyFinding {
+	var findings []models.DependencyFinding
+	for _, affected := range record.Affected {
+		for _, pkg := range pkgs {
+			if pkg.Name != affected.Package.Name || pkg.Ecosystem != affected.Package.Ecosystem {
+				continue
+			}
+			if !versionListed(pkg.Version, affected.Versions) {
+				continue
+			}
+			findings = append(findings, toDependencyFinding(pkg, record))
+		}
+	}
+	return findings
+}
+
+func versionListed(version string, versions []string) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package vulnsrc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultRefreshInterval is how long a source's cached data is trusted
+// before Store.Refresh fetches it again.
+const DefaultRefreshInterval = 24 * time.Hour
+
+// sourceCache is the on-disk record for a single Updater, keyed by source
+// name so each source refreshes independently.
+type sourceCache struct {
+	FetchedAt       time.Time       `json:"fetched_at"`
+	Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+}
+
+// Store refreshes and caches Updater results under CacheDir, one JSON file
+// per source, so a run without network access still has whatever was last
+// fetched.
+type Store struct {
+	CacheDir        string
+	RefreshInterval time.Duration
+}
+
+// NewStore returns a Store caching under cacheDir with DefaultRefreshInterval.
+func NewStore(cacheDir string) *Store {
+	return &Store{CacheDir: cacheDir, RefreshInterval: DefaultRefreshInterval}
+}
+
+// Refresh returns the combined vulnerability set across every updater,
+// refetching any source whose cache is missing or older than
+// s.RefreshInterval. A source whose refetch fails falls back to its last
+// cached data, if any, so one flaky upstream does not fail the whole run.
+func (s *Store) Refresh(ctx context.Context, updaters []Updater) ([]Vulnerability, error) {
+	var all []Vulnerability
+	var firstErr error
+
+	for _, u := range updaters {
+		cached := s.load(u.Name())
+
+		if cached != nil && time.Since(cached.FetchedAt) < s.RefreshInterval {
+			all = append(all, cached.Vulnerabilities...)
+			continue
+		}
+
+		vulns, err := u.Update(ctx)
+		if err != nil {
+			if cached != nil {
+				all = append(all, cached.Vulnerabilities...)
+				continue
+			}
+			if firstErr == nil {
+				firstErr = fmt.Errorf("updating %s: %w", u.Name(), err)
+			}
+			continue
+		}
+
+		if err := s.save(u.Name(), vulns); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("caching %s: %w", u.Name(), err)
+		}
+		all = append(all, vulns...)
+	}
+
+	return all, firstErr
+}
+
+func (s *Store) cachePath(source string) string {
+	return filepath.Join(s.CacheDir, source+".json")
+}
+
+func (s *Store) load(source string) *sourceCache {
+	data, err := os.ReadFile(s.cachePath(source))
+	if err != nil {
+		return nil
+	}
+	var cache sourceCache
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return nil
+	}
+	return &cache
+}
+
+func (s *Store) save(source string, vulns []Vulnerability) error {
+	if err := os.MkdirAll(s.CacheDir, 0o755); err != nil {
+		return err
+	}
+	cache := sourceCache{FetchedAt: time.Now(), Vulnerabilities: vulns}
+	data, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.cachePath(source), data, 0o644)
+}
@@ -0,0 +1,95 @@
+package vulnsrc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeUpdater struct {
+	name string
+	fn   func(ctx context.Context) ([]Vulnerability, error)
+}
+
+func (u *fakeUpdater) Name() string { return u.name }
+func (u *fakeUpdater) Update(ctx context.Context) ([]Vulnerability, error) {
+	return u.fn(ctx)
+}
+
+func TestStoreRefreshFetchesAndCaches(t *testing.T) {
+	store := NewStore(t.TempDir())
+	calls := 0
+	updater := &fakeUpdater{name: "test-source", fn: func(ctx context.Context) ([]Vulnerability, error) {
+		calls++
+		return []Vulnerability{{Source: "test-source", VulnerabilityID: "CVE-1"}}, nil
+	}}
+
+	vulns, err := store.Refresh(context.Background(), []Updater{updater})
+	if err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if len(vulns) != 1 || calls != 1 {
+		t.Fatalf("got %d vulnerabilities after %d calls, want 1 and 1", len(vulns), calls)
+	}
+
+	vulns, err = store.Refresh(context.Background(), []Updater{updater})
+	if err != nil {
+		t.Fatalf("Refresh (second call): %v", err)
+	}
+	if len(vulns) != 1 || calls != 1 {
+		t.Errorf("got %d vulnerabilities after %d calls, want the cache to be reused (still 1 call)", len(vulns), calls)
+	}
+}
+
+func TestStoreRefreshFallsBackToCacheOnUpdateError(t *testing.T) {
+	dir := t.TempDir()
+	store := &Store{CacheDir: dir, RefreshInterval: 0} // always stale, forces a refetch attempt
+
+	working := &fakeUpdater{name: "flaky", fn: func(ctx context.Context) ([]Vulnerability, error) {
+		return []Vulnerability{{Source: "flaky", VulnerabilityID: "CVE-1"}}, nil
+	}}
+	if _, err := store.Refresh(context.Background(), []Updater{working}); err != nil {
+		t.Fatalf("initial Refresh: %v", err)
+	}
+
+	failing := &fakeUpdater{name: "flaky", fn: func(ctx context.Context) ([]Vulnerability, error) {
+		return nil, errors.New("upstream unavailable")
+	}}
+	vulns, err := store.Refresh(context.Background(), []Updater{failing})
+	if err != nil {
+		t.Fatalf("Refresh with a failing updater but a warm cache should not error, got: %v", err)
+	}
+	if len(vulns) != 1 || vulns[0].VulnerabilityID != "CVE-1" {
+		t.Errorf("got %+v, want the stale cached entry from before the failure", vulns)
+	}
+}
+
+func TestStoreRefreshReturnsErrorWithNoCacheAndFailingUpdater(t *testing.T) {
+	store := NewStore(t.TempDir())
+	failing := &fakeUpdater{name: "broken", fn: func(ctx context.Context) ([]Vulnerability, error) {
+		return nil, errors.New("upstream unavailable")
+	}}
+
+	if _, err := store.Refresh(context.Background(), []Updater{failing}); err == nil {
+		t.Error("Refresh with no cache and a failing updater returned nil error")
+	}
+}
+
+func TestStoreRefreshReusesCacheWithinRefreshInterval(t *testing.T) {
+	store := &Store{CacheDir: t.TempDir(), RefreshInterval: time.Hour}
+	calls := 0
+	updater := &fakeUpdater{name: "source", fn: func(ctx context.Context) ([]Vulnerability, error) {
+		calls++
+		return []Vulnerability{{Source: "source"}}, nil
+	}}
+
+	for i := 0; i < 3; i++ {
+		if _, err := store.Refresh(context.Background(), []Updater{updater}); err != nil {
+			t.Fatalf("Refresh #%d: %v", i, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Update called %d times across 3 Refresh calls within RefreshInterval, want 1", calls)
+	}
+}
@@ -0,0 +1,88 @@
+package vulnsrc
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/detective-cli/detective/internal/vulns"
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// Correlate matches each declared dependency against the cached
+// vulnerability set by ecosystem + name, additionally filtering out
+// packages already patched past VulnerableBelow when that's known, and
+// returns one DependencyFinding per match. This mirrors
+// vulns.toDependencyFinding's shape so callers can treat vulnsrc and OSV
+// results identically.
+func Correlate(packages []vulns.Package, vulnerabilities []Vulnerability) []models.DependencyFinding {
+	var findings []models.DependencyFinding
+
+	for _, pkg := range packages {
+		for _, v := range vulnerabilities {
+			if !strings.EqualFold(v.Ecosystem, pkg.Ecosystem) || v.Name != pkg.Name {
+				continue
+			}
+			if v.VulnerableBelow != "" && compareVersions(pkg.Version, v.VulnerableBelow) >= 0 {
+				continue
+			}
+
+			findings = append(findings, models.DependencyFinding{
+				Ecosystem:       pkg.Ecosystem,
+				Name:            pkg.Name,
+				Version:         pkg.Version,
+				VulnerabilityID: v.VulnerabilityID,
+				Summary:         v.Summary,
+				Severity:        cvssToSeverity(v.CVSS),
+				FixedIn:         v.FixedIn,
+			})
+		}
+	}
+
+	return findings
+}
+
+// cvssToSeverity maps a CVSS v3 base score onto our four-level ladder,
+// following the standard FIRST.org bands. A score of 0 (unscored, as with
+// Ubuntu CVE Tracker records) defaults to medium, matching how OSV's
+// unscored advisories are treated in vulns.osvToSeverity.
+func cvssToSeverity(score float64) models.Severity {
+	switch {
+	case score == 0:
+		return models.SeverityMedium
+	case score >= 9.0:
+		return models.SeverityCritical
+	case score >= 7.0:
+		return models.SeverityHigh
+	case score >= 4.0:
+		return models.SeverityMedium
+	default:
+		return models.SeverityLow
+	}
+}
+
+// compareVersions does a best-effort numeric comparison of dotted version
+// strings (ignoring any pre-release/build suffix), returning -1, 0, or 1.
+// Full semver range semantics are out of scope here; this is the same
+// "good enough" heuristic style the repo already uses for CVSS band
+// matching in vulns.containsCVSSBand.
+func compareVersions(a, b string) int {
+	as := strings.Split(strings.SplitN(a, "-", 2)[0], ".")
+	bs := strings.Split(strings.SplitN(b, "-", 2)[0], ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var an, bn int
+		if i < len(as) {
+			an, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			bn, _ = strconv.Atoi(bs[i])
+		}
+		if an != bn {
+			if an < bn {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
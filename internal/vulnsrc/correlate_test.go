@@ -0,0 +1,86 @@
+package vulnsrc
+
+import (
+	"testing"
+
+	"github.com/detective-cli/detective/internal/vulns"
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestCorrelateMatchesByEcosystemAndName(t *testing.T) {
+	packages := []vulns.Package{
+		{Ecosystem: "npm", Name: "lodash", Version: "4.17.15"},
+		{Ecosystem: "Go", Name: "lodash", Version: "1.0.0"}, // same name, different ecosystem: no match
+	}
+	vulnerabilities := []Vulnerability{
+		{Ecosystem: "NPM", Name: "lodash", VulnerabilityID: "CVE-2021-1", CVSS: 7.5},
+	}
+
+	findings := Correlate(packages, vulnerabilities)
+	if len(findings) != 1 {
+		t.Fatalf("got %d findings, want 1 (ecosystem match is case-insensitive, name must match exactly)", len(findings))
+	}
+	if findings[0].Ecosystem != "npm" || findings[0].Severity != models.SeverityHigh {
+		t.Errorf("finding = %+v, want Ecosystem=npm Severity=High", findings[0])
+	}
+}
+
+func TestCorrelateExcludesPackagesAlreadyPatched(t *testing.T) {
+	packages := []vulns.Package{{Ecosystem: "npm", Name: "lodash", Version: "4.17.21"}}
+	vulnerabilities := []Vulnerability{
+		{Ecosystem: "npm", Name: "lodash", VulnerabilityID: "CVE-2021-1", VulnerableBelow: "4.17.21"},
+	}
+
+	findings := Correlate(packages, vulnerabilities)
+	if len(findings) != 0 {
+		t.Errorf("got %d findings, want 0 (installed version is not below VulnerableBelow)", len(findings))
+	}
+}
+
+func TestCorrelateIncludesPackagesBelowFixedVersion(t *testing.T) {
+	packages := []vulns.Package{{Ecosystem: "npm", Name: "lodash", Version: "4.17.15"}}
+	vulnerabilities := []Vulnerability{
+		{Ecosystem: "npm", Name: "lodash", VulnerabilityID: "CVE-2021-1", VulnerableBelow: "4.17.21"},
+	}
+
+	findings := Correlate(packages, vulnerabilities)
+	if len(findings) != 1 {
+		t.Errorf("got %d findings, want 1 (installed version is below VulnerableBelow)", len(findings))
+	}
+}
+
+func TestCVSSToSeverityBands(t *testing.T) {
+	tests := []struct {
+		score float64
+		want  models.Severity
+	}{
+		{0, models.SeverityMedium},
+		{9.8, models.SeverityCritical},
+		{7.5, models.SeverityHigh},
+		{5.0, models.SeverityMedium},
+		{2.0, models.SeverityLow},
+	}
+	for _, tt := range tests {
+		if got := cvssToSeverity(tt.score); got != tt.want {
+			t.Errorf("cvssToSeverity(%v) = %v, want %v", tt.score, got, tt.want)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.3.0", "1.2.9", 1},
+		{"1.2", "1.2.0", 0},
+		{"4.17.21-beta", "4.17.21", 0},
+	}
+	for _, tt := range tests {
+		if got := compareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package vulnsrc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ghsaAdvisoriesURL is GitHub's REST (not GraphQL) Advisory Database
+// endpoint; it works unauthenticated at a lower rate limit, which keeps
+// this updater usable without requiring a token.
+const ghsaAdvisoriesURL = "https://api.github.com/advisories?per_page=100"
+
+// GHSAUpdater fetches published advisories from the GitHub Advisory
+// Database.
+type GHSAUpdater struct {
+	// Token is an optional GitHub token, raising the unauthenticated rate
+	// limit. Requests are made without one if empty.
+	Token string
+	// BaseURL overrides ghsaAdvisoriesURL, for tests.
+	BaseURL string
+}
+
+func (u *GHSAUpdater) Name() string { return "ghsa" }
+
+type ghsaAdvisory struct {
+	GHSAID  string `json:"ghsa_id"`
+	Summary string `json:"summary"`
+	HTMLURL string `json:"html_url"`
+	CVSS    struct {
+		Score float64 `json:"score"`
+	} `json:"cvss"`
+	Vulnerabilities []struct {
+		Package struct {
+			Ecosystem string `json:"ecosystem"`
+			Name      string `json:"name"`
+		} `json:"package"`
+		FirstPatchedVersion string `json:"first_patched_version"`
+	} `json:"vulnerabilities"`
+}
+
+func (u *GHSAUpdater) Update(ctx context.Context) ([]Vulnerability, error) {
+	url := u.BaseURL
+	if url == "" {
+		url = ghsaAdvisoriesURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if u.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+u.Token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching GHSA advisories: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GHSA API returned status %d", resp.StatusCode)
+	}
+
+	var advisories []ghsaAdvisory
+	if err := json.NewDecoder(resp.Body).Decode(&advisories); err != nil {
+		return nil, fmt.Errorf("decoding GHSA advisories: %w", err)
+	}
+
+	var vulns []Vulnerability
+	for _, a := range advisories {
+		for _, v := range a.Vulnerabilities {
+			vulns = append(vulns, Vulnerability{
+				Source:          u.Name(),
+				Ecosystem:       v.Package.Ecosystem,
+				Name:            v.Package.Name,
+				VulnerabilityID: a.GHSAID,
+				CVSS:            a.CVSS.Score,
+				Summary:         a.Summary,
+				AdvisoryURL:     a.HTMLURL,
+				FixedIn:         v.FirstPatchedVersion,
+			})
+		}
+	}
+
+	return vulns, nil
+}
@@ -0,0 +1,54 @@
+package vulnsrc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGHSAUpdaterUpdateParsesAdvisories(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{
+				"ghsa_id": "GHSA-xxxx-yyyy-zzzz",
+				"summary": "Example vulnerability",
+				"html_url": "https://github.com/advisories/GHSA-xxxx-yyyy-zzzz",
+				"cvss": {"score": 8.1},
+				"vulnerabilities": [
+					{"package": {"ecosystem": "npm", "name": "lodash"}, "first_patched_version": "4.17.21"}
+				]
+			}
+		]`))
+	}))
+	defer server.Close()
+
+	u := &GHSAUpdater{BaseURL: server.URL}
+	vulns, err := u.Update(context.Background())
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if len(vulns) != 1 {
+		t.Fatalf("got %d vulnerabilities, want 1", len(vulns))
+	}
+	v := vulns[0]
+	if v.Source != "ghsa" || v.Ecosystem != "npm" || v.Name != "lodash" || v.VulnerabilityID != "GHSA-xxxx-yyyy-zzzz" {
+		t.Errorf("vuln = %+v, want ghsa/npm/lodash/GHSA-xxxx-yyyy-zzzz", v)
+	}
+	if v.CVSS != 8.1 || v.FixedIn != "4.17.21" {
+		t.Errorf("vuln = %+v, want CVSS=8.1 FixedIn=4.17.21", v)
+	}
+}
+
+func TestGHSAUpdaterUpdateNonOKStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	u := &GHSAUpdater{BaseURL: server.URL}
+	if _, err := u.Update(context.Background()); err == nil {
+		t.Error("Update with a 500 response returned nil error")
+	}
+}
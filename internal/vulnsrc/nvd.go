@@ -0,0 +1,152 @@
+package vulnsrc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// nvdFeedURL is NVD's JSON 2.0 API. resultsPerPage is capped well under
+// NVD's public rate limit (5 req/30s without an API key); a full mirror
+// would page through startIndex, but one page is enough to seed the cache
+// for an offline-friendly proof of the source.
+const nvdFeedURL = "https://services.nvd.nist.gov/rest/json/cves/2.0?resultsPerPage=200"
+
+// NVDUpdater fetches recent CVE records from the National Vulnerability
+// Database.
+type NVDUpdater struct {
+	// BaseURL overrides nvdFeedURL, for tests.
+	BaseURL string
+}
+
+func (u *NVDUpdater) Name() string { return "nvd" }
+
+type nvdResponse struct {
+	Vulnerabilities []struct {
+		CVE struct {
+			ID           string `json:"id"`
+			Descriptions []struct {
+				Lang  string `json:"lang"`
+				Value string `json:"value"`
+			} `json:"descriptions"`
+			Metrics struct {
+				CVSSMetricV31 []struct {
+					CVSSData struct {
+						BaseScore float64 `json:"baseScore"`
+					} `json:"cvssData"`
+				} `json:"cvssMetricV31"`
+			} `json:"metrics"`
+			Configurations []struct {
+				Nodes []struct {
+					CPEMatch []struct {
+						Criteria string `json:"criteria"`
+					} `json:"cpeMatch"`
+				} `json:"nodes"`
+			} `json:"configurations"`
+		} `json:"cve"`
+	} `json:"vulnerabilities"`
+}
+
+func (u *NVDUpdater) Update(ctx context.Context) ([]Vulnerability, error) {
+	url := u.BaseURL
+	if url == "" {
+		url = nvdFeedURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching NVD feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("NVD feed returned status %d", resp.StatusCode)
+	}
+
+	var doc nvdResponse
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decoding NVD feed: %w", err)
+	}
+
+	var vulns []Vulnerability
+	for _, entry := range doc.Vulnerabilities {
+		cve := entry.CVE
+		summary := ""
+		for _, d := range cve.Descriptions {
+			if d.Lang == "en" {
+				summary = d.Value
+				break
+			}
+		}
+
+		var cvss float64
+		if len(cve.Metrics.CVSSMetricV31) > 0 {
+			cvss = cve.Metrics.CVSSMetricV31[0].CVSSData.BaseScore
+		}
+
+		for _, name := range cpePackageNames(cve.Configurations) {
+			vulns = append(vulns, Vulnerability{
+				Source:          u.Name(),
+				Ecosystem:       "",
+				Name:            name,
+				VulnerabilityID: cve.ID,
+				CVSS:            cvss,
+				Summary:         summary,
+				AdvisoryURL:     "https://nvd.nist.gov/vuln/detail/" + cve.ID,
+			})
+		}
+	}
+
+	return vulns, nil
+}
+
+// cpePackageNames pulls the product field out of each CPE 2.3 criteria
+// string (cpe:2.3:a:vendor:product:version:...), deduplicated.
+func cpePackageNames(configurations []struct {
+	Nodes []struct {
+		CPEMatch []struct {
+			Criteria string `json:"criteria"`
+		} `json:"cpeMatch"`
+	} `json:"nodes"`
+}) []string {
+	seen := make(map[string]bool)
+	var names []string
+
+	for _, config := range configurations {
+		for _, node := range config.Nodes {
+			for _, match := range node.CPEMatch {
+				parts := splitCPE(match.Criteria)
+				if len(parts) < 5 {
+					continue
+				}
+				product := parts[4]
+				if product == "" || product == "*" || seen[product] {
+					continue
+				}
+				seen[product] = true
+				names = append(names, product)
+			}
+		}
+	}
+
+	return names
+}
+
+func splitCPE(criteria string) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(criteria); i++ {
+		if criteria[i] == ':' {
+			parts = append(parts, criteria[start:i])
+			start = i + 1
+		}
+	}
+	parts = append(parts, criteria[start:])
+	return parts
+}
@@ -0,0 +1,105 @@
+package vulnsrc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNVDUpdaterUpdateParsesVulnerabilities(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"vulnerabilities": [
+				{
+					"cve": {
+						"id": "CVE-2024-0001",
+						"descriptions": [
+							{"lang": "es", "value": "Descripcion"},
+							{"lang": "en", "value": "An example vulnerability"}
+						],
+						"metrics": {"cvssMetricV31": [{"cvssData": {"baseScore": 9.1}}]},
+						"configurations": [
+							{"nodes": [{"cpeMatch": [{"criteria": "cpe:2.3:a:vendor:openssl:1.0.0:*:*:*:*:*:*:*"}]}]}
+						]
+					}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	u := &NVDUpdater{BaseURL: server.URL}
+	vulns, err := u.Update(context.Background())
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if len(vulns) != 1 {
+		t.Fatalf("got %d vulnerabilities, want 1", len(vulns))
+	}
+	v := vulns[0]
+	if v.VulnerabilityID != "CVE-2024-0001" || v.Name != "openssl" || v.CVSS != 9.1 {
+		t.Errorf("vuln = %+v, want CVE-2024-0001/openssl/9.1", v)
+	}
+	if v.Summary != "An example vulnerability" {
+		t.Errorf("Summary = %q, want the English description picked over the Spanish one", v.Summary)
+	}
+}
+
+func TestNVDUpdaterUpdateNonOKStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	u := &NVDUpdater{BaseURL: server.URL}
+	if _, err := u.Update(context.Background()); err == nil {
+		t.Error("Update with a 429 response returned nil error")
+	}
+}
+
+func TestSplitCPE(t *testing.T) {
+	got := splitCPE("cpe:2.3:a:vendor:product:1.0:*:*:*:*:*:*:*")
+	want := []string{"cpe", "2.3", "a", "vendor", "product", "1.0", "*", "*", "*", "*", "*", "*", "*"}
+	if len(got) != len(want) {
+		t.Fatalf("splitCPE = %v (len %d), want len %d", got, len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("splitCPE[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestNVDUpdaterUpdateDeduplicatesPackageNamesAcrossCPEMatches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"vulnerabilities": [
+				{
+					"cve": {
+						"id": "CVE-2024-0002",
+						"configurations": [
+							{"nodes": [{"cpeMatch": [
+								{"criteria": "cpe:2.3:a:vendor:openssl:1.0:*:*:*:*:*:*:*"},
+								{"criteria": "cpe:2.3:a:vendor:openssl:1.1:*:*:*:*:*:*:*"},
+								{"criteria": "cpe:2.3:a:vendor:*:1.0:*:*:*:*:*:*:*"}
+							]}]}
+						]
+					}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	u := &NVDUpdater{BaseURL: server.URL}
+	vulns, err := u.Update(context.Background())
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if len(vulns) != 1 || vulns[0].Name != "openssl" {
+		t.Errorf("got %v, want a single openssl entry (deduplicated across CPE matches, wildcard product skipped)", vulns)
+	}
+}
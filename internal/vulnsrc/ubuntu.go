@@ -0,0 +1,127 @@
+package vulnsrc
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ubuntuCVETrackerRepo is the canonical git mirror of the Ubuntu CVE
+// Tracker, the same source Clair's Ubuntu updater reads from.
+const ubuntuCVETrackerRepo = "https://git.launchpad.net/ubuntu-cve-tracker"
+
+// UbuntuCVETrackerUpdater clones (or pulls) the Ubuntu CVE Tracker git repo
+// and parses its per-CVE text records. Debian-style "Candidate"/"Priority"
+// records don't carry CVSS scores, so CVSS is left at 0 and priority is
+// folded into correlate.go's severity mapping via Summary instead.
+type UbuntuCVETrackerUpdater struct {
+	// CheckoutDir is where the tracker repo is cloned/pulled. Required.
+	CheckoutDir string
+}
+
+func (u *UbuntuCVETrackerUpdater) Name() string { return "ubuntu-cve-tracker" }
+
+func (u *UbuntuCVETrackerUpdater) Update(ctx context.Context) ([]Vulnerability, error) {
+	if err := u.sync(ctx); err != nil {
+		return nil, err
+	}
+
+	activeDir := filepath.Join(u.CheckoutDir, "active")
+	entries, err := os.ReadDir(activeDir)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", activeDir, err)
+	}
+
+	var vulns []Vulnerability
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), "CVE-") {
+			continue
+		}
+		v, err := parseUbuntuCVEFile(filepath.Join(activeDir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		vulns = append(vulns, v...)
+	}
+
+	return vulns, nil
+}
+
+func (u *UbuntuCVETrackerUpdater) sync(ctx context.Context) error {
+	if _, err := os.Stat(filepath.Join(u.CheckoutDir, ".git")); err == nil {
+		cmd := exec.CommandContext(ctx, "git", "pull", "--ff-only")
+		cmd.Dir = u.CheckoutDir
+		return cmd.Run()
+	}
+
+	if err := os.MkdirAll(filepath.Dir(u.CheckoutDir), 0o755); err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, "git", "clone", "--depth", "1", ubuntuCVETrackerRepo, u.CheckoutDir)
+	return cmd.Run()
+}
+
+// parseUbuntuCVEFile reads one CVE record of the form:
+//
+//	Candidate: CVE-2024-12345
+//	PublicDate: ...
+//	References:
+//	 https://...
+//	Description:
+//	 ...
+//	Priority: high
+//	Patches_pkg-name:
+//
+// and returns one Vulnerability per "Patches_<package>:" line, since that's
+// how the tracker records which packages a CVE affects.
+func parseUbuntuCVEFile(path string) ([]Vulnerability, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var candidate, priority, description string
+	var packages []string
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "Candidate:"):
+			candidate = strings.TrimSpace(strings.TrimPrefix(line, "Candidate:"))
+		case strings.HasPrefix(line, "Priority:"):
+			priority = strings.TrimSpace(strings.TrimPrefix(line, "Priority:"))
+		case strings.HasPrefix(line, "Description:"):
+			description = strings.TrimSpace(strings.TrimPrefix(line, "Description:"))
+		case strings.HasPrefix(line, "Patches_"):
+			rest := strings.TrimPrefix(line, "Patches_")
+			if idx := strings.Index(rest, ":"); idx > 0 {
+				packages = append(packages, rest[:idx])
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if candidate == "" {
+		return nil, fmt.Errorf("no Candidate line in %s", path)
+	}
+
+	var vulns []Vulnerability
+	for _, pkg := range packages {
+		vulns = append(vulns, Vulnerability{
+			Source:          "ubuntu-cve-tracker",
+			Ecosystem:       "Ubuntu",
+			Name:            pkg,
+			VulnerabilityID: candidate,
+			Summary:         fmt.Sprintf("[%s priority] %s", priority, description),
+			AdvisoryURL:     "https://ubuntu.com/security/" + candidate,
+		})
+	}
+	return vulns, nil
+}
@@ -0,0 +1,66 @@
+package vulnsrc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeCVEFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "CVE-2024-12345")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	return path
+}
+
+func TestParseUbuntuCVEFileOnePerPatchedPackage(t *testing.T) {
+	path := writeCVEFile(t, `Candidate: CVE-2024-12345
+PublicDate: 2024-01-01
+References:
+ https://example.com
+Description:
+ An example vulnerability.
+Priority: high
+Patches_openssl:
+Patches_curl:
+`)
+
+	vulns, err := parseUbuntuCVEFile(path)
+	if err != nil {
+		t.Fatalf("parseUbuntuCVEFile: %v", err)
+	}
+	if len(vulns) != 2 {
+		t.Fatalf("got %d vulnerabilities, want 2 (one per Patches_ line)", len(vulns))
+	}
+	for _, v := range vulns {
+		if v.VulnerabilityID != "CVE-2024-12345" || v.Source != "ubuntu-cve-tracker" || v.Ecosystem != "Ubuntu" {
+			t.Errorf("vuln = %+v, want CVE-2024-12345/ubuntu-cve-tracker/Ubuntu", v)
+		}
+		if v.Summary != "[high priority] An example vulnerability." {
+			t.Errorf("Summary = %q, want priority+description folded in", v.Summary)
+		}
+	}
+	if vulns[0].Name != "openssl" || vulns[1].Name != "curl" {
+		t.Errorf("names = %q, %q, want openssl, curl in file order", vulns[0].Name, vulns[1].Name)
+	}
+}
+
+func TestParseUbuntuCVEFileMissingCandidateErrors(t *testing.T) {
+	path := writeCVEFile(t, "Priority: high\nPatches_openssl:\n")
+	if _, err := parseUbuntuCVEFile(path); err == nil {
+		t.Error("parseUbuntuCVEFile with no Candidate line returned nil error")
+	}
+}
+
+func TestParseUbuntuCVEFileNoPatchedPackages(t *testing.T) {
+	path := writeCVEFile(t, "Candidate: CVE-2024-12345\nPriority: high\n")
+	vulns, err := parseUbuntuCVEFile(path)
+	if err != nil {
+		t.Fatalf("parseUbuntuCVEFile: %v", err)
+	}
+	if len(vulns) != 0 {
+		t.Errorf("got %d vulnerabilities for a record with no Patches_ lines, want 0", len(vulns))
+	}
+}
@@ -0,0 +1,33 @@
+// Package vulnsrc maintains a local, periodically-refreshed mirror of
+// upstream vulnerability trackers (NVD, the Ubuntu CVE Tracker, GitHub's
+// Advisory Database) and correlates it against dependencies discovered by
+// internal/vulns, in the spirit of Clair's Ubuntu CVE Tracker updater: each
+// source implements Updater, and a Store refreshes and caches each source's
+// data independently so an offline run still has whatever was last fetched.
+package vulnsrc
+
+import "context"
+
+// Vulnerability is one advisory record as normalized from an upstream
+// source, ready to correlate against a vulns.Package by ecosystem + name.
+type Vulnerability struct {
+	Source          string  // "nvd", "ubuntu-cve-tracker", "ghsa"
+	Ecosystem       string  // matches vulns.Package.Ecosystem, e.g. "Go", "npm", "PyPI"
+	Name            string  // package name
+	VulnerabilityID string  // CVE or GHSA identifier
+	CVSS            float64 // CVSS v3 base score, 0 if unscored
+	Summary         string
+	AdvisoryURL     string
+	FixedIn         string // earliest version known to fix VulnerabilityID, if reported
+	VulnerableBelow string // versions strictly below this are considered affected, if reported
+}
+
+// Updater fetches a source's current vulnerability data. Implementations
+// should be safe to call repeatedly; Store is responsible for deciding when
+// a refresh is due.
+type Updater interface {
+	// Name identifies the source for cache keying and logging.
+	Name() string
+	// Update fetches the source's full current vulnerability set.
+	Update(ctx context.Context) ([]Vulnerability, error)
+}
@@ -0,0 +1,152 @@
+// Package engine compiles the CEL predicates attached to pkg/inference/probes
+// definitions and evaluates them against a flat projection of
+// models.Evidence (see Project), so a probe's firing condition is data
+// rather than the hand-rolled if/else ladders that used to live in
+// internal/inference/enhanced.go. Only definitions with a non-empty
+// Predicate participate; probes.Render still renders the resulting finding,
+// so a predicate-driven probe reads identically to a hand-fired one in the
+// report.
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/google/cel-go/cel"
+
+	"github.com/detective-cli/detective/pkg/inference/probes"
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// env is the single CEL environment every predicate and variable compiles
+// against: one dynamically-typed "evidence" variable, matching the map
+// Project returns.
+var env = mustEnv()
+
+func mustEnv() *cel.Env {
+	e, err := cel.NewEnv(cel.Variable("evidence", cel.DynType))
+	if err != nil {
+		panic(fmt.Sprintf("engine: building CEL environment: %v", err))
+	}
+	return e
+}
+
+// compiledProbe is a probes.Definition with its Predicate and Variables
+// already parsed into cel.Program, so Evaluate does no compilation per scan.
+type compiledProbe struct {
+	def       probes.Definition
+	predicate cel.Program
+	variables map[string]cel.Program
+}
+
+// Engine evaluates every probe definition that carries a CEL Predicate
+// against a models.Evidence snapshot.
+type Engine struct {
+	probes []compiledProbe
+}
+
+// New compiles the Predicate and Variables of every definition that has one
+// into an Engine. Definitions with an empty Predicate are skipped: they
+// haven't been migrated off hand-written Go yet, same as before this
+// package existed. Returns an error naming the first definition whose
+// expression fails to compile.
+func New(defs []probes.Definition) (*Engine, error) {
+	var eng Engine
+	for _, def := range defs {
+		if def.Predicate == "" {
+			continue
+		}
+
+		predicate, err := compile(def.Predicate)
+		if err != nil {
+			return nil, fmt.Errorf("engine: probe %s: predicate: %w", def.ID, err)
+		}
+
+		variables := make(map[string]cel.Program, len(def.Variables))
+		for name, expr := range def.Variables {
+			program, err := compile(expr)
+			if err != nil {
+				return nil, fmt.Errorf("engine: probe %s: variable %s: %w", def.ID, name, err)
+			}
+			variables[name] = program
+		}
+
+		eng.probes = append(eng.probes, compiledProbe{def: def, predicate: predicate, variables: variables})
+	}
+	return &eng, nil
+}
+
+func compile(expr string) (cel.Program, error) {
+	ast, issues := env.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	return env.Program(ast)
+}
+
+// Evaluate runs every compiled predicate against evidence's projection and
+// renders a models.Finding via probes.Render for each one that returns true.
+func (e *Engine) Evaluate(evidence models.Evidence) ([]models.Finding, error) {
+	activation := map[string]interface{}{"evidence": Project(evidence)}
+
+	var findings []models.Finding
+	for _, p := range e.probes {
+		out, _, err := p.predicate.Eval(activation)
+		if err != nil {
+			return nil, fmt.Errorf("engine: probe %s: evaluating predicate: %w", p.def.ID, err)
+		}
+		fire, ok := out.Value().(bool)
+		if !ok || !fire {
+			continue
+		}
+
+		vars, err := p.resolveVariables(activation)
+		if err != nil {
+			return nil, err
+		}
+
+		var evidenceLines []string
+		if p.def.EvidenceTemplate != "" {
+			line, err := renderText(p.def.ID, p.def.EvidenceTemplate, vars)
+			if err != nil {
+				return nil, fmt.Errorf("engine: probe %s: rendering evidenceTemplate: %w", p.def.ID, err)
+			}
+			evidenceLines = []string{line}
+		}
+
+		finding, err := probes.Render(p.def.ID, vars, evidenceLines)
+		if err != nil {
+			return nil, fmt.Errorf("engine: probe %s: %w", p.def.ID, err)
+		}
+		findings = append(findings, finding)
+	}
+	return findings, nil
+}
+
+// resolveVariables evaluates every compiled Variables program against
+// activation and stringifies the results into the vars map probes.Render
+// and EvidenceTemplate expect.
+func (p compiledProbe) resolveVariables(activation map[string]interface{}) (map[string]string, error) {
+	vars := make(map[string]string, len(p.variables))
+	for name, program := range p.variables {
+		out, _, err := program.Eval(activation)
+		if err != nil {
+			return nil, fmt.Errorf("engine: probe %s: evaluating variable %s: %w", p.def.ID, name, err)
+		}
+		vars[name] = fmt.Sprint(out.Value())
+	}
+	return vars, nil
+}
+
+func renderText(name, source string, vars map[string]string) (string, error) {
+	tmpl, err := template.New(name).Parse(source)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
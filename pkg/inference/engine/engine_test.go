@@ -0,0 +1,101 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/inference/probes"
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func mustNoTestFilesDef(t *testing.T) probes.Definition {
+	t.Helper()
+	def, ok := probes.Get("noTestFiles")
+	if !ok {
+		t.Fatal(`probes.Get("noTestFiles") not found`)
+	}
+	return def
+}
+
+func TestNewCompilesAllShippedDefinitions(t *testing.T) {
+	if _, err := New(probes.All()); err != nil {
+		t.Fatalf("New(probes.All()): %v", err)
+	}
+}
+
+func TestNewRejectsInvalidPredicate(t *testing.T) {
+	def := mustNoTestFilesDef(t)
+	def.Predicate = "evidence.filesystem.test_files =="
+
+	if _, err := New([]probes.Definition{def}); err == nil {
+		t.Fatal("New with a malformed predicate returned nil error")
+	}
+}
+
+func TestEvaluateFiresWhenPredicateTrue(t *testing.T) {
+	eng, err := New([]probes.Definition{mustNoTestFilesDef(t)})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	evidence := models.Evidence{
+		FileSystem: models.FileSystemEvidence{
+			CategorizedFiles: models.CategorizedFiles{SourceFiles: 15, TestFiles: 0},
+		},
+	}
+
+	findings, err := eng.Evaluate(evidence)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if len(findings) != 1 {
+		t.Fatalf("got %d findings, want 1: %+v", len(findings), findings)
+	}
+	if findings[0].ProbeID != "noTestFiles" {
+		t.Errorf("ProbeID = %q, want noTestFiles", findings[0].ProbeID)
+	}
+	if len(findings[0].Evidence) != 1 || findings[0].Evidence[0] != "15 source files, 0 test files" {
+		t.Errorf("Evidence = %v, want rendered evidenceTemplate", findings[0].Evidence)
+	}
+}
+
+func TestEvaluateSilentWhenPredicateFalse(t *testing.T) {
+	eng, err := New([]probes.Definition{mustNoTestFilesDef(t)})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	evidence := models.Evidence{
+		FileSystem: models.FileSystemEvidence{
+			CategorizedFiles: models.CategorizedFiles{SourceFiles: 15, TestFiles: 5},
+		},
+	}
+
+	findings, err := eng.Evaluate(evidence)
+	if err != nil {
+		t.Fatalf("Evaluate: %v", err)
+	}
+	if len(findings) != 0 {
+		t.Fatalf("got %d findings, want 0 (test_files > 0): %+v", len(findings), findings)
+	}
+}
+
+func TestProjectLargeFileCounts(t *testing.T) {
+	evidence := models.Evidence{
+		FileSystem: models.FileSystemEvidence{
+			LargestFiles: []models.FileInfo{
+				{Size: 2 * 1024 * 1024, Category: models.CategorySource},
+				{Size: 2 * 1024 * 1024, Category: models.CategoryBuildArtifact},
+				{Size: 100, Category: models.CategorySource}, // below threshold, not counted
+			},
+		},
+	}
+
+	proj := Project(evidence)
+	fs := proj["filesystem"].(map[string]interface{})
+	if fs["large_source_files"] != 1 {
+		t.Errorf("large_source_files = %v, want 1", fs["large_source_files"])
+	}
+	if fs["large_build_artifacts"] != 1 {
+		t.Errorf("large_build_artifacts = %v, want 1", fs["large_build_artifacts"])
+	}
+}
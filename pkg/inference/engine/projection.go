@@ -0,0 +1,50 @@
+package engine
+
+import "github.com/detective-cli/detective/pkg/models"
+
+// largeFileThreshold matches the size analyzeFileSystemEnhanced used to
+// flag a file as "large" before that logic moved into probe predicates.
+const largeFileThreshold = 1024 * 1024 // 1MB
+
+// Project flattens a models.Evidence snapshot into the nested
+// map[string]interface{} that probe predicates and variables address as
+// evidence.<category>.<field> (e.g. evidence.git.total_commits). Only the
+// fields referenced by shipped predicates are projected here; add to this
+// as more probes migrate off hand-written Go.
+func Project(e models.Evidence) map[string]interface{} {
+	largeSource, largeBuildArtifact := 0, 0
+	for _, f := range e.FileSystem.LargestFiles {
+		if f.Size <= largeFileThreshold {
+			continue
+		}
+		switch f.Category {
+		case models.CategorySource:
+			largeSource++
+		case models.CategoryBuildArtifact:
+			largeBuildArtifact++
+		}
+	}
+
+	return map[string]interface{}{
+		"git": map[string]interface{}{
+			"is_repository":            e.Git.IsRepository,
+			"total_commits":            e.Git.TotalCommits,
+			"contributors":             e.Git.Contributors,
+			"uncommitted_changes":      e.Git.UncommittedChanges,
+			"commit_message_quality":   e.Git.CommitMessageQuality,
+			"commits_last_7_days":      e.Git.CommitFrequency.Last7Days,
+			"commits_last_30_days":     e.Git.CommitFrequency.Last30Days,
+			"average_commits_per_week": e.Git.CommitFrequency.AveragePerWeek,
+			"last_commit_date":         e.Git.LastCommitDate.Format("2006-01-02"),
+		},
+		"filesystem": map[string]interface{}{
+			"total_files":           e.FileSystem.TotalFiles,
+			"total_directories":     e.FileSystem.TotalDirectories,
+			"documentation_files":   e.FileSystem.CategorizedFiles.DocumentationFiles,
+			"test_files":            e.FileSystem.CategorizedFiles.TestFiles,
+			"source_files":          e.FileSystem.CategorizedFiles.SourceFiles,
+			"large_source_files":    largeSource,
+			"large_build_artifacts": largeBuildArtifact,
+		},
+	}
+}
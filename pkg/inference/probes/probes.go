@@ -0,0 +1,163 @@
+// Package probes defines the findings detective's built-in analyzers emit,
+// as data rather than code: each finding is a YAML definition under
+// probes/<id>/def.yml (short, motivation, implementation,
+// remediationEffort, severity, category, recommendations, and a
+// descriptionTemplate for the evidence-dependent text), embedded into the
+// binary and parsed at startup. This mirrors how OSSF Scorecard decouples
+// probe definitions from check logic: tuning wording, severity, or
+// remediation steps no longer requires recompiling, and it opens the door
+// to translating descriptions without touching Go source.
+//
+// A definition may also carry a Predicate (and supporting Variables): a
+// CEL expression that pkg/inference/engine compiles and evaluates against
+// evidence to decide whether the probe fires at all, not just how its
+// finding reads. Definitions without one still fire from hand-written Go
+// in internal/inference, same as before.
+//
+// This is deliberately separate from internal/inference/probes, which is
+// the engine that evaluates probes (built-in Go predicates plus
+// organization-supplied --probes-dir YAML) against evidence; this package
+// only owns the static text/metadata half of the built-in probe set.
+package probes
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"text/template"
+
+	"github.com/detective-cli/detective/pkg/models"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed probes
+var defsFS embed.FS
+
+// Definition is a single probe's static metadata, as loaded from its
+// probes/<id>/def.yml.
+type Definition struct {
+	ID                  string            `yaml:"id"`
+	Short               string            `yaml:"short"`
+	Motivation          string            `yaml:"motivation"`
+	Implementation      string            `yaml:"implementation"`
+	RemediationEffort   string            `yaml:"remediationEffort"` // "low", "medium", or "high"
+	Severity            string            `yaml:"severity"`          // "low", "medium", "high", or "critical"
+	Category            string            `yaml:"category"`
+	Recommendations     []string          `yaml:"recommendations"`
+	DescriptionTemplate string            `yaml:"descriptionTemplate"` // text/template source, rendered against Render's vars
+	Predicate           string            `yaml:"predicate"`           // CEL expression against the evidence projection in pkg/inference/engine; empty means this probe still fires from hand-written Go
+	Variables           map[string]string `yaml:"variables"`           // named CEL expressions evaluated when Predicate fires, stringified into Render's vars
+	EvidenceTemplate    string            `yaml:"evidenceTemplate"`    // text/template source rendered against the resolved Variables, becoming the finding's Evidence line
+}
+
+var registry = map[string]Definition{}
+
+func init() {
+	paths, err := fs.Glob(defsFS, "probes/*/def.yml")
+	if err != nil {
+		panic(fmt.Sprintf("probes: globbing embedded definitions: %v", err))
+	}
+	for _, path := range paths {
+		data, err := defsFS.ReadFile(path)
+		if err != nil {
+			panic(fmt.Sprintf("probes: reading %s: %v", path, err))
+		}
+
+		var def Definition
+		if err := yaml.Unmarshal(data, &def); err != nil {
+			panic(fmt.Sprintf("probes: parsing %s: %v", path, err))
+		}
+		if def.ID == "" {
+			panic(fmt.Sprintf("probes: %s: missing id", path))
+		}
+		if _, dup := registry[def.ID]; dup {
+			panic(fmt.Sprintf("probes: duplicate id %q (%s)", def.ID, path))
+		}
+		registry[def.ID] = def
+	}
+}
+
+// Get returns the definition registered under id.
+func Get(id string) (Definition, bool) {
+	def, ok := registry[id]
+	return def, ok
+}
+
+// All returns every registered definition, sorted by ID so callers like
+// pkg/inference/engine that compile them at startup get a deterministic
+// order to report errors in.
+func All() []Definition {
+	defs := make([]Definition, 0, len(registry))
+	for _, def := range registry {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].ID < defs[j].ID })
+	return defs
+}
+
+// Render looks up id and renders it into a models.Finding: vars are
+// substituted into the definition's descriptionTemplate and its
+// recommendations (both referenced as {{ .Name }}), and copied verbatim
+// into the finding's Values so later report stages can inspect them.
+// evidence becomes the finding's raw Evidence lines, since those come from
+// scan output the definition itself has no way to predict.
+func Render(id string, vars map[string]string, evidence []string) (models.Finding, error) {
+	def, ok := Get(id)
+	if !ok {
+		return models.Finding{}, fmt.Errorf("probes: unknown probe id %q", id)
+	}
+
+	description, err := renderTemplate(def.ID, def.DescriptionTemplate, vars)
+	if err != nil {
+		return models.Finding{}, fmt.Errorf("probes: %s: rendering description: %w", def.ID, err)
+	}
+
+	recommendations := make([]string, len(def.Recommendations))
+	for i, r := range def.Recommendations {
+		rendered, err := renderTemplate(def.ID, r, vars)
+		if err != nil {
+			return models.Finding{}, fmt.Errorf("probes: %s: rendering recommendation %d: %w", def.ID, i, err)
+		}
+		recommendations[i] = rendered
+	}
+
+	return models.Finding{
+		ProbeID:           def.ID,
+		Outcome:           models.OutcomeFail, // Render only ever runs for a probe that fired
+		Severity:          severityFromString(def.Severity),
+		Title:             def.Short,
+		Description:       description,
+		Evidence:          evidence,
+		Recommendations:   recommendations,
+		Category:          models.FindingCategory(def.Category),
+		RemediationEffort: def.RemediationEffort,
+		Values:            vars,
+	}, nil
+}
+
+func renderTemplate(name, source string, vars map[string]string) (string, error) {
+	tmpl, err := template.New(name).Parse(source)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func severityFromString(s string) models.Severity {
+	switch s {
+	case "critical":
+		return models.SeverityCritical
+	case "high":
+		return models.SeverityHigh
+	case "medium":
+		return models.SeverityMedium
+	default:
+		return models.SeverityLow
+	}
+}
@@ -0,0 +1,94 @@
+package probes
+
+import (
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestAllIsSortedAndNonEmpty(t *testing.T) {
+	defs := All()
+	if len(defs) == 0 {
+		t.Fatal("All() returned no definitions")
+	}
+	for i := 1; i < len(defs); i++ {
+		if defs[i-1].ID >= defs[i].ID {
+			t.Fatalf("All() not sorted by ID: %q >= %q", defs[i-1].ID, defs[i].ID)
+		}
+	}
+}
+
+func TestAllDefinitionsHaveRequiredFields(t *testing.T) {
+	for _, def := range All() {
+		if def.Short == "" {
+			t.Errorf("%s: missing short", def.ID)
+		}
+		if def.Severity == "" {
+			t.Errorf("%s: missing severity", def.ID)
+		}
+		if def.Category == "" {
+			t.Errorf("%s: missing category", def.ID)
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	def, ok := Get("noTestFiles")
+	if !ok {
+		t.Fatal("Get(\"noTestFiles\") not found")
+	}
+	if def.Short != "No Test Files Detected" {
+		t.Errorf("Short = %q, want %q", def.Short, "No Test Files Detected")
+	}
+
+	if _, ok := Get("doesNotExist"); ok {
+		t.Error("Get(\"doesNotExist\") returned ok=true")
+	}
+}
+
+func TestRenderSubstitutesVarsIntoDescriptionAndRecommendations(t *testing.T) {
+	finding, err := Render("noTestFiles", map[string]string{"SourceFiles": "42"}, []string{"42 source files, 0 test files"})
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if finding.ProbeID != "noTestFiles" {
+		t.Errorf("ProbeID = %q, want noTestFiles", finding.ProbeID)
+	}
+	if finding.Outcome != models.OutcomeFail {
+		t.Errorf("Outcome = %v, want OutcomeFail", finding.Outcome)
+	}
+	if finding.Severity != models.SeverityMedium {
+		t.Errorf("Severity = %v, want SeverityMedium", finding.Severity)
+	}
+	if len(finding.Evidence) != 1 || finding.Evidence[0] != "42 source files, 0 test files" {
+		t.Errorf("Evidence = %v", finding.Evidence)
+	}
+	if finding.Values["SourceFiles"] != "42" {
+		t.Errorf("Values[SourceFiles] = %q, want 42", finding.Values["SourceFiles"])
+	}
+}
+
+func TestRenderUnknownID(t *testing.T) {
+	if _, err := Render("doesNotExist", nil, nil); err == nil {
+		t.Fatal("Render with an unknown id returned nil error")
+	}
+}
+
+func TestSeverityFromString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want models.Severity
+	}{
+		{"critical", models.SeverityCritical},
+		{"high", models.SeverityHigh},
+		{"medium", models.SeverityMedium},
+		{"low", models.SeverityLow},
+		{"", models.SeverityLow},
+		{"garbage", models.SeverityLow},
+	}
+	for _, tt := range tests {
+		if got := severityFromString(tt.in); got != tt.want {
+			t.Errorf("severityFromString(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
@@ -7,6 +7,7 @@ type Evidence struct {
 	FileSystem        FileSystemEvidence
 	Git               GitEvidence
 	CodeMarkers       []CodeMarker
+	CodeSmells        []CodeSmell
 	Timeline          TimelineEvidence
 	InvestigatorNotes []string
 	ProjectType       ProjectType
@@ -15,14 +16,16 @@ type Evidence struct {
 
 // FileSystemEvidence contains file system analysis data
 type FileSystemEvidence struct {
-	TotalFiles       int
-	TotalDirectories int
-	TotalSize        int64
-	FileTypes        map[string]int
-	LargestFiles     []FileInfo
-	SkippedDirs      []string
-	SkippedDirsCount int
-	CategorizedFiles CategorizedFiles
+	TotalFiles        int
+	TotalDirectories  int
+	TotalSize         int64
+	FileTypes         map[string]int
+	LargestFiles      []FileInfo
+	SkippedDirs       []string // directory names excluded by ScanOptions.ExcludeDirs/SkipHidden, i.e. "the user asked to skip this"
+	SkippedDirsCount  int
+	IgnoredFilesCount int // files excluded by ScanOptions.RespectGitignore, i.e. "VCS says ignore this"
+	IgnoredDirsCount  int
+	CategorizedFiles  CategorizedFiles
 }
 
 // FileInfo represents information about a single file
@@ -62,6 +65,7 @@ type CategorizedFiles struct {
 // GitEvidence contains git repository analysis data
 type GitEvidence struct {
 	IsRepository         bool
+	RepoPath             string // root path AnalyzeRepository opened, so later passes (e.g. pkg/relnotes) can reopen it
 	TotalCommits         int
 	Contributors         int
 	FirstCommitDate      time.Time
@@ -72,6 +76,47 @@ type GitEvidence struct {
 	UncommittedChanges   bool
 	BranchCount          int
 	CommitMessageQuality float64 // 0.0-1.0 score
+	CodeActivity         CodeActivity
+}
+
+// CodeActivity summarizes recent code churn and ownership concentration,
+// computed by internal/git.AnalyzeRepository over a configurable trailing
+// window rather than the repository's full history: churn and bus factor
+// describe a project's current health, and commits from years ago say
+// little about who would need to be paged today.
+type CodeActivity struct {
+	Window          time.Duration
+	AuthorActivity  []AuthorActivity
+	FileChurn       []FileChurn
+	DirectoryOwners []DirectoryBusFactor
+}
+
+// AuthorActivity is one author's total lines added/removed across every
+// commit within CodeActivity.Window.
+type AuthorActivity struct {
+	Name         string
+	Email        string
+	LinesAdded   int
+	LinesRemoved int
+}
+
+// FileChurn is one file's total lines changed (additions plus deletions)
+// across every commit that touched it within CodeActivity.Window, used to
+// flag hotspots when combined with DirectoryBusFactor.
+type FileChurn struct {
+	Path         string
+	LinesChanged int
+	CommitCount  int
+}
+
+// DirectoryBusFactor is the minimum number of authors whose combined
+// blame-line ownership of a directory's current source files reaches 50%:
+// a bus factor of 1 means one person's departure would take most of that
+// directory's institutional knowledge with them.
+type DirectoryBusFactor struct {
+	Directory string
+	BusFactor int
+	TopOwners []string // authors counted toward BusFactor, most-owned first
 }
 
 // CommitInfo represents information about a git commit
@@ -115,14 +160,151 @@ type CodeMarker struct {
 	Content string
 }
 
+// CodeSmellType identifies which check in scanner.ScanCodeSmells produced a
+// CodeSmell.
+type CodeSmellType string
+
+const (
+	SmellHighComplexity      CodeSmellType = "high-complexity"
+	SmellDuplicatedBranches  CodeSmellType = "duplicated-branches"
+	SmellIdenticalConditions CodeSmellType = "identical-conditions"
+	SmellOneIterationLoop    CodeSmellType = "one-iteration-loop"
+)
+
+// CodeSmell represents a structural code quality issue detected by
+// scanner.ScanCodeSmells: a function whose cognitive complexity crosses the
+// configured threshold, or one of the SonarJS-derived branch/condition/loop
+// anti-patterns it also checks for.
+type CodeSmell struct {
+	Type       CodeSmellType
+	File       string
+	Line       int
+	Function   string
+	Complexity int // populated for SmellHighComplexity, zero otherwise
+}
+
 // Finding represents an inference made from evidence
 type Finding struct {
-	Severity        Severity
-	Title           string
-	Description     string
-	Evidence        []string
-	Recommendations []string // Actionable next steps
-	Category        FindingCategory
+	Severity          Severity
+	Title             string
+	Description       string
+	Evidence          []string
+	Recommendations   []string // Actionable next steps
+	Category          FindingCategory
+	Values            map[string]string  // structured fields a probe populated, e.g. filePath, commitSHA, secretType
+	ProbeID           string             // id of the pkg/inference/probes.Definition this finding was rendered from, empty for findings not backed by one
+	Outcome           ProbeOutcome       // the probe's Scorecard-style result for this finding; empty for findings not backed by one (see ProbeOutcome)
+	RemediationEffort string             // "low", "medium", or "high", carried over from the probe definition
+	Signatures        []FindingSignature // stable identities computed by inference.SignFindings, used to recognize this finding across scans
+	Flags             []FindingFlag      // dispositions attached by inference.ApplySuppressions from .detective/suppressions.yml
+	State             FindingState       // triage lifecycle state, merged in by inference.ApplyTriage from .detective/triage.json
+	StateHistory      []StateTransition  // every state change recorded for this finding's signature, oldest first
+}
+
+// ProbeOutcome is a probe's result for a single finding, modeled after
+// OpenSSF Scorecard's probe/finding split: a probe doesn't just report
+// problems, it reports a definite outcome for the check it ran, so a
+// policy engine consuming findings can tell "probe ran and found nothing
+// wrong" apart from "probe didn't apply here" or "probe itself errored".
+// Detective's probes currently only ever render a finding for the Fail
+// case (see pkg/inference/probes.Render and internal/inference/probes.Engine.Run);
+// the other outcomes are part of the vocabulary for probes that adopt them.
+type ProbeOutcome string
+
+const (
+	OutcomeFail          ProbeOutcome = "Fail"
+	OutcomePass          ProbeOutcome = "Pass"
+	OutcomeNotApplicable ProbeOutcome = "NotApplicable"
+	OutcomeError         ProbeOutcome = "Error"
+)
+
+// FindingState is a finding's position in the triage workflow. The zero
+// value is intentionally not a valid state: inference.ApplyTriage always
+// assigns StateOpen to a finding with no stored triage record, so a
+// Finding with State == "" has simply never been through that pass.
+type FindingState string
+
+const (
+	StateOpen      FindingState = "open"
+	StateConfirmed FindingState = "confirmed"
+	StateDismissed FindingState = "dismissed"
+	StateResolved  FindingState = "resolved"
+)
+
+// StateTransition records one change of a finding's triage State, as
+// captured by `detective triage`.
+type StateTransition struct {
+	From   FindingState
+	To     FindingState
+	At     time.Time
+	Reason string
+}
+
+// FindingFlagType classifies why a user suppressed a finding.
+type FindingFlagType string
+
+const (
+	FlagFalsePositive FindingFlagType = "falsePositive" // not a real issue; excluded from health scoring
+	FlagAcceptedRisk  FindingFlagType = "acceptedRisk"  // real, but knowingly accepted; excluded from health scoring
+	FlagWontFix       FindingFlagType = "wontFix"       // real and not accepted, just not being worked on; still scored
+	FlagPolicyIgnored FindingFlagType = "policyIgnored" // path matched an internal/policy Ignore glob; excluded from health scoring and policy evaluation
+	FlagWaived        FindingFlagType = "waived"        // matched an internal/policy waiver that hasn't expired; excluded from health scoring and policy evaluation
+)
+
+// FindingFlag records a user's disposition on a finding, attached by
+// inference.ApplySuppressions from a matching entry in
+// .detective/suppressions.yml.
+type FindingFlag struct {
+	Type      FindingFlagType
+	Reason    string
+	Author    string
+	CreatedAt time.Time
+}
+
+// SignatureAlgorithm names how a FindingSignature.Value was computed.
+type SignatureAlgorithm string
+
+const (
+	// SignatureHash covers ProbeID + Category + Evidence content. It's
+	// available on every finding, but coarse: it doesn't anchor to a
+	// specific file/line, so it can conflate two occurrences of the same
+	// probe with identical evidence text.
+	SignatureHash SignatureAlgorithm = "hash"
+	// SignatureLocation covers ProbeID + file + line, from a finding's
+	// Values. It's only available on findings whose probe recorded a
+	// location (mainly security findings), but is precise enough to
+	// survive unrelated evidence text changing between scans.
+	SignatureLocation SignatureAlgorithm = "location"
+)
+
+// FindingSignature is one stable identity computed for a Finding, so that
+// two scans of the same target can recognize "this is the same finding"
+// despite each scan allocating a fresh Finding value. A Finding can carry
+// more than one signature computed by different algorithms; Priority
+// ranks them so DiffFindings can prefer the most specific algorithm
+// available on both sides of a comparison and fall back to a coarser one.
+type FindingSignature struct {
+	Algorithm SignatureAlgorithm
+	Value     string
+	Priority  int // higher wins when more than one algorithm matches on both sides
+}
+
+// FindingsDiff classifies every finding from two scans of the same target,
+// matched by FindingSignature, as newly Added, Resolved (present before,
+// absent now), Persisted (same identity, same Severity/Description), or
+// Changed (same identity, different Severity/Description).
+type FindingsDiff struct {
+	Added     []Finding
+	Resolved  []Finding
+	Persisted []Finding
+	Changed   []FindingChange
+}
+
+// FindingChange is a Finding whose identity persisted across scans but
+// whose reported severity or description changed.
+type FindingChange struct {
+	Previous Finding
+	Current  Finding
 }
 
 // FindingCategory represents the type of finding
@@ -172,6 +354,51 @@ type Report struct {
 	HealthBreakdown HealthBreakdown
 	ReportHash      string
 	Context         string // "student", "enterprise", "default"
+	ReleaseNotes    ReleaseNotes
+	Status          ReportStatus
+	SourceHashes    []string // for a report produced by inference.MergeReports, the sorted ReportHash of every input, so provenance is auditable
+}
+
+// ReportStatus records where a scan run stood at the time its report was
+// captured. Running reports are only ever seen mid-scan (e.g. one shard of
+// a sharded CI run reporting in early); a finished detective invocation
+// always produces Success.
+type ReportStatus string
+
+const (
+	ReportStatusRunning ReportStatus = "running"
+	ReportStatusError   ReportStatus = "error"
+	ReportStatusSuccess ReportStatus = "success"
+)
+
+// ReleaseNotes is the categorized commit history between two refs, as
+// produced by pkg/relnotes.GenerateReleaseNotes. FromRef is empty when no
+// range was requested, in which case Entries is empty too.
+type ReleaseNotes struct {
+	FromRef string
+	ToRef   string
+	Entries []ReleaseNoteEntry
+}
+
+// ReleaseNoteCategory buckets a commit for release-notes grouping.
+type ReleaseNoteCategory string
+
+const (
+	ReleaseNoteBreaking ReleaseNoteCategory = "Breaking"
+	ReleaseNoteFeature  ReleaseNoteCategory = "Features"
+	ReleaseNoteFix      ReleaseNoteCategory = "Fixes"
+	ReleaseNoteOther    ReleaseNoteCategory = "Other"
+)
+
+// ReleaseNoteEntry is one commit as classified for release notes.
+type ReleaseNoteEntry struct {
+	Category  ReleaseNoteCategory
+	Hash      string
+	Subject   string // commit summary with the conventional-commit/emoji prefix stripped
+	PRNumber  string // extracted from a trailing "(#123)", empty if absent
+	CoAuthors []string
+	Breaking  bool
+	Author    string
 }
 
 // HealthBreakdown shows weighted scoring components
@@ -193,18 +420,20 @@ type ProjectType struct {
 
 // SecurityEvidence contains security analysis findings
 type SecurityEvidence struct {
-	HardcodedSecrets  []SecretFinding
-	SQLInjectionRisks []SecurityRisk
-	XSSRisks          []SecurityRisk
-	InsecurePatterns  []SecurityRisk
+	HardcodedSecrets       []SecretFinding
+	SQLInjectionRisks      []SecurityRisk
+	XSSRisks               []SecurityRisk
+	InsecurePatterns       []SecurityRisk
+	VulnerableDependencies []DependencyFinding
 }
 
 // SecretFinding represents a potential hardcoded secret
 type SecretFinding struct {
-	File    string
-	Line    int
-	Type    string // "api-key", "password", "token", etc.
-	Pattern string
+	File     string
+	Line     int
+	Type     string // "api-key", "password", "token", "generic-secret", etc.
+	Pattern  string
+	Verified bool // true if a live verification call confirmed the credential is active
 }
 
 // SecurityRisk represents a security vulnerability pattern
@@ -214,4 +443,17 @@ type SecurityRisk struct {
 	Type        string
 	Description string
 	Severity    Severity
+	Confidence  string // "high" for taint-confirmed findings, "low" for regex-only matches; empty for legacy callers
+}
+
+// DependencyFinding represents a known vulnerability in a declared
+// dependency, as reported by an advisory feed such as OSV.dev.
+type DependencyFinding struct {
+	Ecosystem       string // "npm", "Go", "PyPI", "RubyGems", "Packagist", etc.
+	Name            string
+	Version         string
+	VulnerabilityID string // CVE or GHSA identifier
+	Summary         string
+	Severity        Severity
+	FixedIn         string // earliest version known to fix VulnerabilityID, if reported
 }
@@ -0,0 +1,177 @@
+// Package relnotes composes categorized release notes from a repository's
+// commit history, in the style of kubebuilder-release-tools' notes
+// composer: each commit is classified by its conventional-commit or emoji
+// prefix into Breaking/Features/Fixes/Other buckets, with PR numbers and
+// co-authors pulled out of the message body.
+package relnotes
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/detective-cli/detective/pkg/models"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// conventionalPrefixes maps a conventional-commit type to the category it
+// falls into. Unlisted types (chore, docs, style, test, ci, build, ...)
+// fall through to ReleaseNoteOther.
+var conventionalPrefixes = map[string]models.ReleaseNoteCategory{
+	"feat":     models.ReleaseNoteFeature,
+	"fix":      models.ReleaseNoteFix,
+	"perf":     models.ReleaseNoteFix,
+	"refactor": models.ReleaseNoteOther,
+	"chore":    models.ReleaseNoteOther,
+	"docs":     models.ReleaseNoteOther,
+}
+
+// emojiPrefixes maps a common gitmoji-style prefix to its category,
+// mirroring the conventional-commit mapping above.
+var emojiPrefixes = map[string]models.ReleaseNoteCategory{
+	":warning:":  models.ReleaseNoteBreaking,
+	":sparkles:": models.ReleaseNoteFeature,
+	":bug:":      models.ReleaseNoteFix,
+	":seedling:": models.ReleaseNoteOther,
+}
+
+var (
+	conventionalPrefixRe = regexp.MustCompile(`(?is)^([a-z]+)(\([^)]*\))?(!)?:\s*(.*)$`)
+	prNumberRe           = regexp.MustCompile(`\(#(\d+)\)\s*$`)
+	coAuthorRe           = regexp.MustCompile(`(?im)^Co-authored-by:\s*(.+)$`)
+)
+
+// GenerateReleaseNotes walks the commit history of the repository recorded
+// in evidence.Git (opened at evidence.Git.RepoPath) from fromRef
+// (exclusive) to toRef (inclusive) and returns one categorized entry per
+// commit. fromRef may be empty, in which case the full history reachable
+// from toRef is used.
+func GenerateReleaseNotes(evidence models.Evidence, fromRef, toRef string) (models.ReleaseNotes, error) {
+	notes := models.ReleaseNotes{FromRef: fromRef, ToRef: toRef}
+
+	if !evidence.Git.IsRepository || evidence.Git.RepoPath == "" {
+		return notes, fmt.Errorf("evidence has no git repository to walk")
+	}
+
+	repo, err := git.PlainOpen(evidence.Git.RepoPath)
+	if err != nil {
+		return notes, fmt.Errorf("opening repository: %w", err)
+	}
+
+	toHash, err := resolveRef(repo, toRef)
+	if err != nil {
+		return notes, fmt.Errorf("resolving %q: %w", toRef, err)
+	}
+
+	var fromHash *plumbing.Hash
+	if fromRef != "" {
+		h, err := resolveRef(repo, fromRef)
+		if err != nil {
+			return notes, fmt.Errorf("resolving %q: %w", fromRef, err)
+		}
+		fromHash = &h
+	}
+
+	commitIter, err := repo.Log(&git.LogOptions{From: toHash})
+	if err != nil {
+		return notes, fmt.Errorf("walking log: %w", err)
+	}
+
+	err = commitIter.ForEach(func(c *object.Commit) error {
+		if fromHash != nil && c.Hash == *fromHash {
+			return storer.ErrStop
+		}
+		notes.Entries = append(notes.Entries, classifyCommit(c))
+		return nil
+	})
+	if err != nil {
+		return notes, fmt.Errorf("walking log: %w", err)
+	}
+
+	return notes, nil
+}
+
+func resolveRef(repo *git.Repository, ref string) (plumbing.Hash, error) {
+	hash, err := repo.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return *hash, nil
+}
+
+// classifyCommit buckets a single commit into a ReleaseNoteEntry.
+func classifyCommit(c *object.Commit) models.ReleaseNoteEntry {
+	subject := strings.TrimSpace(strings.SplitN(c.Message, "\n", 2)[0])
+
+	category, breaking, rest := ParseCommitMessage(subject)
+
+	var prNumber string
+	if m := prNumberRe.FindStringSubmatch(rest); m != nil {
+		prNumber = m[1]
+		rest = strings.TrimSpace(prNumberRe.ReplaceAllString(rest, ""))
+	}
+
+	var coAuthors []string
+	for _, m := range coAuthorRe.FindAllStringSubmatch(c.Message, -1) {
+		coAuthors = append(coAuthors, strings.TrimSpace(m[1]))
+	}
+
+	if strings.Contains(c.Message, "BREAKING CHANGE:") {
+		breaking = true
+	}
+	if breaking {
+		category = models.ReleaseNoteBreaking
+	}
+
+	return models.ReleaseNoteEntry{
+		Category:  category,
+		Hash:      c.Hash.String()[:8],
+		Subject:   rest,
+		PRNumber:  prNumber,
+		CoAuthors: coAuthors,
+		Breaking:  breaking,
+		Author:    c.Author.Name,
+	}
+}
+
+// ParseCommitMessage classifies a commit subject line by its
+// conventional-commit prefix (feat:, fix(scope):, feat!: ...) or emoji
+// prefix (:sparkles: ...), returning the category, whether it carries a
+// "!" breaking-change marker, and the subject with the prefix stripped. A
+// subject matching neither convention is ReleaseNoteOther with the subject
+// returned unchanged.
+func ParseCommitMessage(subject string) (category models.ReleaseNoteCategory, breaking bool, rest string) {
+	if m := conventionalPrefixRe.FindStringSubmatch(subject); m != nil {
+		commitType := strings.ToLower(m[1])
+		if cat, ok := conventionalPrefixes[commitType]; ok {
+			return cat, m[3] == "!", m[4]
+		}
+	}
+
+	for prefix, cat := range emojiPrefixes {
+		if strings.HasPrefix(subject, prefix) {
+			return cat, cat == models.ReleaseNoteBreaking, strings.TrimSpace(strings.TrimPrefix(subject, prefix))
+		}
+	}
+
+	return models.ReleaseNoteOther, false, subject
+}
+
+// IsConventional reports whether subject parses as a recognized
+// conventional-commit or emoji prefix, for internal/git's
+// CommitMessageQuality heuristic.
+func IsConventional(subject string) bool {
+	if m := conventionalPrefixRe.FindStringSubmatch(strings.TrimSpace(subject)); m != nil {
+		_, ok := conventionalPrefixes[strings.ToLower(m[1])]
+		return ok
+	}
+	for prefix := range emojiPrefixes {
+		if strings.HasPrefix(strings.TrimSpace(subject), prefix) {
+			return true
+		}
+	}
+	return false
+}
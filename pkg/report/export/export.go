@@ -0,0 +1,67 @@
+// Package export renders a completed models.Report into the file formats
+// other tooling expects to ingest: SARIF for code-scanning tabs, GitLab SAST
+// JSON for its pipeline security widget, JUnit XML for CI test reporters,
+// Markdown and Jira wiki markup for humans, and PDF for anything that wants
+// a standalone document. Each format is a small Exporter behind a shared
+// interface so cmd/detective can select one or more by name from
+// --report-formats without knowing their encodings.
+package export
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// Exporter renders report to w in one specific format. Implementations
+// should return any encoding/writer error from w verbatim, the way
+// pkg/report/sarif.WriteSARIF does.
+type Exporter interface {
+	Export(report models.Report, w io.Writer) error
+}
+
+// registered pairs an Exporter with the file extension its output is
+// conventionally saved under.
+type registered struct {
+	exporter Exporter
+	ext      string
+}
+
+// registry is keyed by the format name accepted in --report-formats.
+var registry = map[string]registered{
+	"sarif":    {sarifExporter{}, ".sarif.json"},
+	"gitlab":   {gitlabExporter{}, ".gitlab.json"},
+	"junit":    {junitExporter{}, ".junit.xml"},
+	"markdown": {markdownExporter{}, ".md"},
+	"jira":     {jiraExporter{}, ".jira.txt"},
+	"pdf":      {pdfExporter{}, ".pdf"},
+}
+
+// Lookup returns the Exporter and conventional file extension registered
+// for format, and false if format isn't one --report-formats accepts.
+func Lookup(format string) (Exporter, string, bool) {
+	r, ok := registry[format]
+	return r.exporter, r.ext, ok
+}
+
+// Formats lists every registered format name, sorted, for use in
+// --report-formats' usage string and in validation error messages.
+func Formats() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UnknownFormatError reports a --report-formats entry that isn't registered.
+type UnknownFormatError struct {
+	Format string
+}
+
+func (e UnknownFormatError) Error() string {
+	return fmt.Sprintf("unknown report format %q (supported: %v)", e.Format, Formats())
+}
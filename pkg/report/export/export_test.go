@@ -0,0 +1,50 @@
+package export
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLookupKnownFormat(t *testing.T) {
+	exporter, ext, ok := Lookup("junit")
+	if !ok {
+		t.Fatal("Lookup(\"junit\") returned ok=false")
+	}
+	if ext != ".junit.xml" {
+		t.Errorf("ext = %q, want .junit.xml", ext)
+	}
+	if _, ok := exporter.(junitExporter); !ok {
+		t.Errorf("exporter = %T, want junitExporter", exporter)
+	}
+}
+
+func TestLookupUnknownFormat(t *testing.T) {
+	if _, _, ok := Lookup("pptx"); ok {
+		t.Error("Lookup(\"pptx\") returned ok=true for an unregistered format")
+	}
+}
+
+func TestFormatsIsSortedAndCoversEveryExporter(t *testing.T) {
+	want := []string{"gitlab", "jira", "junit", "markdown", "pdf", "sarif"}
+	got := Formats()
+	if len(got) != len(want) {
+		t.Fatalf("Formats() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Formats()[%d] = %q, want %q (not sorted or registry mismatch)", i, got[i], want[i])
+		}
+	}
+}
+
+func TestUnknownFormatError(t *testing.T) {
+	err := UnknownFormatError{Format: "pptx"}
+	if !strings.Contains(err.Error(), "pptx") {
+		t.Errorf("Error() = %q, want it to mention the unknown format", err.Error())
+	}
+	for _, name := range Formats() {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("Error() = %q, want it to list supported format %q", err.Error(), name)
+		}
+	}
+}
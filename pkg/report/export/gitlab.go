@@ -0,0 +1,153 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// gitlabReportVersion is the GitLab SAST report schema version this
+// exporter targets (https://docs.gitlab.com/ee/development/integrations/secure.html).
+const gitlabReportVersion = "15.0.6"
+
+// gitlabExporter renders report as GitLab SAST JSON, the format GitLab CI's
+// security dashboard and merge request widget ingest from an
+// artifacts:reports:sast job.
+type gitlabExporter struct{}
+
+func (gitlabExporter) Export(report models.Report, w io.Writer) error {
+	doc := gitlabDocument{
+		Version: gitlabReportVersion,
+		Scan: gitlabScan{
+			Type: "sast",
+			Analyzer: gitlabScanner{
+				ID:   "detective",
+				Name: "detective",
+			},
+			Scanner: gitlabScanner{
+				ID:   "detective",
+				Name: "detective",
+			},
+			Status: string(report.Status),
+		},
+		Vulnerabilities: buildGitlabVulnerabilities(report.Findings),
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+func buildGitlabVulnerabilities(findings []models.Finding) []gitlabVulnerability {
+	vulnerabilities := []gitlabVulnerability{}
+
+	for i, f := range findings {
+		vuln := gitlabVulnerability{
+			ID:          gitlabVulnerabilityID(f, i),
+			Category:    "sast",
+			Name:        f.Title,
+			Message:     f.Title,
+			Description: f.Description,
+			Severity:    gitlabSeverity(f.Severity),
+			Confidence:  "Unknown",
+			Scanner:     gitlabScanner{ID: "detective", Name: "detective"},
+			Identifiers: []gitlabIdentifier{gitlabIdentifierFor(f)},
+		}
+		if file, line, ok := firstLocation(f); ok {
+			vuln.Location = gitlabLocation{File: file, StartLine: line}
+		}
+		vulnerabilities = append(vulnerabilities, vuln)
+	}
+
+	return vulnerabilities
+}
+
+// gitlabVulnerabilityID prefers the finding's strongest stable signature,
+// falling back to its position in the report so every vulnerability still
+// gets a unique id even for findings not backed by a probe.
+func gitlabVulnerabilityID(f models.Finding, index int) string {
+	var best models.FindingSignature
+	for _, sig := range f.Signatures {
+		if sig.Priority >= best.Priority {
+			best = sig
+		}
+	}
+	if best.Value != "" {
+		return best.Value
+	}
+	return f.Title + "-" + strconv.Itoa(index)
+}
+
+func gitlabIdentifierFor(f models.Finding) gitlabIdentifier {
+	name := f.ProbeID
+	if name == "" {
+		name = f.Title
+	}
+	return gitlabIdentifier{
+		Type:  "detective_probe_id",
+		Name:  name,
+		Value: name,
+	}
+}
+
+// gitlabSeverity maps onto GitLab's five-level severity vocabulary; our
+// Severity enum has no Info level, so that value is unused here but kept
+// for parity with the schema.
+func gitlabSeverity(sev models.Severity) string {
+	switch sev {
+	case models.SeverityCritical:
+		return "Critical"
+	case models.SeverityHigh:
+		return "High"
+	case models.SeverityMedium:
+		return "Medium"
+	case models.SeverityLow:
+		return "Low"
+	default:
+		return "Unknown"
+	}
+}
+
+type gitlabDocument struct {
+	Version         string                `json:"version"`
+	Vulnerabilities []gitlabVulnerability `json:"vulnerabilities"`
+	Scan            gitlabScan            `json:"scan"`
+}
+
+type gitlabScan struct {
+	Analyzer gitlabScanner `json:"analyzer"`
+	Scanner  gitlabScanner `json:"scanner"`
+	Type     string        `json:"type"`
+	Status   string        `json:"status"`
+}
+
+type gitlabScanner struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type gitlabVulnerability struct {
+	ID          string             `json:"id"`
+	Category    string             `json:"category"`
+	Name        string             `json:"name"`
+	Message     string             `json:"message"`
+	Description string             `json:"description"`
+	Severity    string             `json:"severity"`
+	Confidence  string             `json:"confidence"`
+	Scanner     gitlabScanner      `json:"scanner"`
+	Location    gitlabLocation     `json:"location"`
+	Identifiers []gitlabIdentifier `json:"identifiers"`
+}
+
+type gitlabLocation struct {
+	File      string `json:"file,omitempty"`
+	StartLine int    `json:"start_line,omitempty"`
+}
+
+type gitlabIdentifier struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
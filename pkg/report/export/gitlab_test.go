@@ -0,0 +1,75 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestGitlabExportStructure(t *testing.T) {
+	report := models.Report{
+		Status: models.ReportStatus("success"),
+		Findings: []models.Finding{
+			{
+				ProbeID:     "hardcodedSecrets",
+				Title:       "Hardcoded Secret",
+				Description: "found a key",
+				Severity:    models.SeverityCritical,
+				Evidence:    []string{"a.go:7 - key"},
+				Signatures:  []models.FindingSignature{{Algorithm: "hash", Value: "sig-1", Priority: 0}},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := (gitlabExporter{}).Export(report, &buf); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	var doc gitlabDocument
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if doc.Version != gitlabReportVersion {
+		t.Errorf("Version = %q, want %q", doc.Version, gitlabReportVersion)
+	}
+	if doc.Scan.Type != "sast" || doc.Scan.Status != "success" {
+		t.Errorf("Scan = %+v, want Type=sast Status=success", doc.Scan)
+	}
+	if len(doc.Vulnerabilities) != 1 {
+		t.Fatalf("got %d vulnerabilities, want 1", len(doc.Vulnerabilities))
+	}
+	v := doc.Vulnerabilities[0]
+	if v.ID != "sig-1" {
+		t.Errorf("ID = %q, want the finding's signature value", v.ID)
+	}
+	if v.Severity != "Critical" {
+		t.Errorf("Severity = %q, want Critical", v.Severity)
+	}
+	if v.Location.File != "a.go" || v.Location.StartLine != 7 {
+		t.Errorf("Location = %+v, want File=a.go StartLine=7", v.Location)
+	}
+}
+
+func TestGitlabVulnerabilityIDFallsBackToTitleAndIndex(t *testing.T) {
+	f := models.Finding{Title: "Unsigned Finding"}
+	if got := gitlabVulnerabilityID(f, 3); got != "Unsigned Finding-3" {
+		t.Errorf("gitlabVulnerabilityID = %q, want title-index fallback", got)
+	}
+}
+
+func TestGitlabSeverityMapping(t *testing.T) {
+	tests := map[models.Severity]string{
+		models.SeverityCritical: "Critical",
+		models.SeverityHigh:     "High",
+		models.SeverityMedium:   "Medium",
+		models.SeverityLow:      "Low",
+	}
+	for sev, want := range tests {
+		if got := gitlabSeverity(sev); got != want {
+			t.Errorf("gitlabSeverity(%v) = %q, want %q", sev, got, want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// jiraExporter renders report as Jira/Confluence wiki markup, for pasting
+// directly into an issue description or wiki page. Jira wiki tables use
+// "||" for header cells and "|" for body cells with no dash separator row
+// (unlike Markdown's "|---|"), and "----" on its own line is a horizontal
+// rule rather than a table row.
+type jiraExporter struct{}
+
+func (jiraExporter) Export(report models.Report, w io.Writer) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "h1. Detective Report: %s\n\n", report.TargetPath)
+	fmt.Fprintf(&sb, "*Investigated*: %s\n", report.InvestigatedAt.Format("2006-01-02 15:04:05 MST"))
+	fmt.Fprintf(&sb, "*Health Score*: %d/100\n", report.HealthScore)
+	fmt.Fprintf(&sb, "*Findings*: %d\n\n", len(report.Findings))
+	sb.WriteString("----\n\n")
+
+	if len(report.Findings) == 0 {
+		sb.WriteString("No significant issues detected.\n")
+		_, err := io.WriteString(w, sb.String())
+		return err
+	}
+
+	findings := append([]models.Finding(nil), report.Findings...)
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Severity > findings[j].Severity
+	})
+
+	sb.WriteString("h2. Findings\n\n")
+	sb.WriteString("||Severity||Category||Title||\n")
+	for _, f := range findings {
+		fmt.Fprintf(&sb, "|%s|%s|%s|\n", f.Severity, f.Category, f.Title)
+	}
+	sb.WriteString("\n----\n\n")
+
+	for _, f := range findings {
+		fmt.Fprintf(&sb, "h3. [%s] %s\n\n", f.Severity, f.Title)
+		fmt.Fprintf(&sb, "%s\n\n", f.Description)
+		if len(f.Evidence) > 0 {
+			sb.WriteString("*Evidence:*\n")
+			for _, e := range f.Evidence {
+				fmt.Fprintf(&sb, "* %s\n", e)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
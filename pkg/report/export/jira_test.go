@@ -0,0 +1,47 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestJiraExportCleanReport(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (jiraExporter{}).Export(models.Report{TargetPath: "/repo"}, &buf); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "h1. Detective Report: /repo") {
+		t.Errorf("missing report header, got:\n%s", out)
+	}
+	if !strings.Contains(out, "No significant issues detected.") {
+		t.Errorf("clean report should say no issues detected, got:\n%s", out)
+	}
+}
+
+func TestJiraExportTableAndSections(t *testing.T) {
+	report := models.Report{
+		Findings: []models.Finding{
+			{Title: "Critical Finding", Category: models.FindingSecurity, Severity: models.SeverityCritical, Description: "desc", Evidence: []string{"e1"}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := (jiraExporter{}).Export(report, &buf); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	out := buf.String()
+
+	if !strings.Contains(out, "||Severity||Category||Title||") {
+		t.Errorf("missing Jira-style table header row, got:\n%s", out)
+	}
+	if !strings.Contains(out, "h3. [CRITICAL] Critical Finding") {
+		t.Errorf("missing per-finding h3 section, got:\n%s", out)
+	}
+	if !strings.Contains(out, "* e1") {
+		t.Errorf("missing bulleted evidence line, got:\n%s", out)
+	}
+}
@@ -0,0 +1,83 @@
+package export
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// junitExporter renders report as a single JUnit XML test suite, one
+// testcase per finding, so CI systems with a JUnit test reporter (but no
+// native SARIF/SAST support) can surface findings as failed tests. A clean
+// report is one passing, empty-failure testcase rather than an empty
+// suite, so "no findings" still shows up as a green run instead of nothing
+// at all.
+type junitExporter struct{}
+
+func (junitExporter) Export(report models.Report, w io.Writer) error {
+	suite := junitTestSuite{
+		Name:      "detective",
+		Tests:     len(report.Findings),
+		Failures:  len(report.Findings),
+		Timestamp: report.InvestigatedAt.Format("2006-01-02T15:04:05"),
+	}
+
+	if len(report.Findings) == 0 {
+		suite.Tests = 1
+		suite.TestCases = []junitTestCase{{
+			Name:      "no findings",
+			ClassName: "detective",
+		}}
+	} else {
+		for _, f := range report.Findings {
+			tc := junitTestCase{
+				Name:      f.Title,
+				ClassName: string(f.Category),
+				Failure: &junitFailure{
+					Message: f.Description,
+					Type:    f.Severity.String(),
+					Text:    fmt.Sprintf("%s\n\n%s", f.Description, junitEvidenceText(f)),
+				},
+			}
+			suite.TestCases = append(suite.TestCases, tc)
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(suite)
+}
+
+func junitEvidenceText(f models.Finding) string {
+	var text string
+	for _, e := range f.Evidence {
+		text += e + "\n"
+	}
+	return text
+}
+
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
@@ -0,0 +1,59 @@
+package export
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestJUnitExportCleanReportIsOnePassingCase(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (junitExporter{}).Export(models.Report{}, &buf); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	var suite junitTestSuite
+	if err := xml.Unmarshal(buf.Bytes(), &suite); err != nil {
+		t.Fatalf("output is not valid XML: %v", err)
+	}
+	if suite.Tests != 1 || suite.Failures != 0 {
+		t.Errorf("suite = %+v, want Tests=1 Failures=0 for a clean report", suite)
+	}
+	if len(suite.TestCases) != 1 || suite.TestCases[0].Failure != nil {
+		t.Errorf("TestCases = %+v, want one passing testcase", suite.TestCases)
+	}
+}
+
+func TestJUnitExportOneFailureCasePerFinding(t *testing.T) {
+	report := models.Report{
+		Findings: []models.Finding{
+			{Title: "Hardcoded Secret", Category: models.FindingSecurity, Severity: models.SeverityHigh, Description: "found a key", Evidence: []string{"a.go:1 - key"}},
+			{Title: "No Tests", Category: models.FindingCodeQuality, Severity: models.SeverityMedium, Description: "no tests"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := (junitExporter{}).Export(report, &buf); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+
+	var suite junitTestSuite
+	if err := xml.Unmarshal(buf.Bytes(), &suite); err != nil {
+		t.Fatalf("output is not valid XML: %v", err)
+	}
+	if suite.Tests != 2 || suite.Failures != 2 {
+		t.Fatalf("suite = %+v, want Tests=2 Failures=2", suite)
+	}
+	if len(suite.TestCases) != 2 {
+		t.Fatalf("got %d testcases, want 2", len(suite.TestCases))
+	}
+	first := suite.TestCases[0]
+	if first.Name != "Hardcoded Secret" || first.ClassName != "security" {
+		t.Errorf("first testcase = %+v, want Name=Hardcoded Secret ClassName=security", first)
+	}
+	if first.Failure == nil || first.Failure.Type != "HIGH" {
+		t.Errorf("first testcase failure = %+v, want Type=HIGH", first.Failure)
+	}
+}
@@ -0,0 +1,31 @@
+package export
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// evidenceLocation matches the "file:line - description" shape that
+// internal/inference's evidence builders format security findings' Evidence
+// into, the same convention pkg/report/sarif relies on for its locations.
+var evidenceLocation = regexp.MustCompile(`^(.+):(\d+) - `)
+
+// firstLocation returns the file and line of the first evidence line that
+// matches evidenceLocation, and false if none do (most non-security
+// findings carry no file/line-shaped evidence).
+func firstLocation(f models.Finding) (file string, line int, ok bool) {
+	for _, e := range f.Evidence {
+		m := evidenceLocation.FindStringSubmatch(e)
+		if m == nil {
+			continue
+		}
+		lineNum, err := strconv.Atoi(m[2])
+		if err != nil {
+			continue
+		}
+		return m[1], lineNum, true
+	}
+	return "", 0, false
+}
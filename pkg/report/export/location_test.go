@@ -0,0 +1,26 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestFirstLocationMatches(t *testing.T) {
+	f := models.Finding{Evidence: []string{"5 TODO markers found", "internal/security/security.go:42 - hardcoded secret"}}
+
+	file, line, ok := firstLocation(f)
+	if !ok {
+		t.Fatal("firstLocation returned ok=false, want a match on the second evidence line")
+	}
+	if file != "internal/security/security.go" || line != 42 {
+		t.Errorf("firstLocation = (%q, %d), want (internal/security/security.go, 42)", file, line)
+	}
+}
+
+func TestFirstLocationNoMatch(t *testing.T) {
+	f := models.Finding{Evidence: []string{"5 TODO markers found"}}
+	if _, _, ok := firstLocation(f); ok {
+		t.Error("firstLocation returned ok=true for evidence with no file:line shape")
+	}
+}
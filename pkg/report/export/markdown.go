@@ -0,0 +1,64 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// markdownExporter renders report as a GitHub-flavored Markdown document:
+// a findings table followed by one subsection per finding with its
+// evidence, matching how pkg/relnotes' output reads for commit history.
+type markdownExporter struct{}
+
+func (markdownExporter) Export(report models.Report, w io.Writer) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# Detective Report: %s\n\n", report.TargetPath)
+	fmt.Fprintf(&sb, "- **Investigated**: %s\n", report.InvestigatedAt.Format("2006-01-02 15:04:05 MST"))
+	fmt.Fprintf(&sb, "- **Health Score**: %d/100\n", report.HealthScore)
+	fmt.Fprintf(&sb, "- **Findings**: %d\n\n", len(report.Findings))
+
+	if len(report.Findings) == 0 {
+		sb.WriteString("No significant issues detected.\n")
+		_, err := io.WriteString(w, sb.String())
+		return err
+	}
+
+	findings := append([]models.Finding(nil), report.Findings...)
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Severity > findings[j].Severity
+	})
+
+	sb.WriteString("## Findings\n\n")
+	sb.WriteString("| Severity | Category | Title |\n")
+	sb.WriteString("|---|---|---|\n")
+	for _, f := range findings {
+		fmt.Fprintf(&sb, "| %s | %s | %s |\n", f.Severity, f.Category, markdownEscapeCell(f.Title))
+	}
+	sb.WriteString("\n")
+
+	for _, f := range findings {
+		fmt.Fprintf(&sb, "### [%s] %s\n\n", f.Severity, f.Title)
+		fmt.Fprintf(&sb, "%s\n\n", f.Description)
+		if len(f.Evidence) > 0 {
+			sb.WriteString("**Evidence:**\n\n")
+			for _, e := range f.Evidence {
+				fmt.Fprintf(&sb, "- %s\n", e)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// markdownEscapeCell escapes the one character ("|") that would otherwise
+// break out of a table cell.
+func markdownEscapeCell(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
@@ -0,0 +1,50 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestMarkdownExportCleanReport(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (markdownExporter{}).Export(models.Report{TargetPath: "/repo"}, &buf); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "# Detective Report: /repo") {
+		t.Errorf("missing report header, got:\n%s", out)
+	}
+	if !strings.Contains(out, "No significant issues detected.") {
+		t.Errorf("clean report should say no issues detected, got:\n%s", out)
+	}
+}
+
+func TestMarkdownExportOrdersBySeverityAndEscapesPipes(t *testing.T) {
+	report := models.Report{
+		Findings: []models.Finding{
+			{Title: "Low | Pipe", Category: models.FindingCodeQuality, Severity: models.SeverityLow},
+			{Title: "Critical Finding", Category: models.FindingSecurity, Severity: models.SeverityCritical},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := (markdownExporter{}).Export(report, &buf); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	out := buf.String()
+
+	if !strings.Contains(out, `Low \| Pipe`) {
+		t.Errorf("table cell pipe not escaped, got:\n%s", out)
+	}
+	criticalIdx := strings.Index(out, "Critical Finding")
+	lowIdx := strings.Index(out, "Low | Pipe")
+	if criticalIdx == -1 || lowIdx == -1 || criticalIdx > lowIdx {
+		t.Errorf("expected Critical Finding to sort before the low-severity finding, got:\n%s", out)
+	}
+	if !strings.Contains(out, "### [CRITICAL] Critical Finding") {
+		t.Errorf("missing per-finding subsection, got:\n%s", out)
+	}
+}
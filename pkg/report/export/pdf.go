@@ -0,0 +1,187 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+const (
+	pdfLinesPerPage = 54
+	pdfLineHeight   = 14
+	pdfTopMargin    = 760
+	pdfLeftMargin   = 50
+	pdfFontSize     = 10
+	pdfWrapWidth    = 100
+)
+
+// pdfExporter renders report as a standalone PDF document, for archiving or
+// attaching a scan result outside any CI integration. It writes the raw PDF
+// objects directly (Helvetica text only, no embedded images or layout)
+// rather than pulling in a PDF library, the same stdlib-only approach
+// internal/vulns and pkg/report/sarif take for their own formats.
+type pdfExporter struct{}
+
+func (pdfExporter) Export(report models.Report, w io.Writer) error {
+	pages := pdfPaginate(pdfReportLines(report), pdfLinesPerPage)
+	return writePDF(w, pages)
+}
+
+func pdfReportLines(report models.Report) []string {
+	lines := []string{
+		fmt.Sprintf("Detective Report: %s", report.TargetPath),
+		fmt.Sprintf("Investigated: %s", report.InvestigatedAt.Format("2006-01-02 15:04:05 MST")),
+		fmt.Sprintf("Health Score: %d/100", report.HealthScore),
+		fmt.Sprintf("Findings: %d", len(report.Findings)),
+		"",
+	}
+
+	if len(report.Findings) == 0 {
+		return append(lines, "No significant issues detected.")
+	}
+
+	findings := append([]models.Finding(nil), report.Findings...)
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Severity > findings[j].Severity
+	})
+
+	for _, f := range findings {
+		lines = append(lines, fmt.Sprintf("[%s] %s", f.Severity, f.Title))
+		lines = append(lines, pdfWrap(f.Description, pdfWrapWidth)...)
+		for _, e := range f.Evidence {
+			lines = append(lines, pdfWrap("  - "+e, pdfWrapWidth)...)
+		}
+		lines = append(lines, "")
+	}
+
+	return lines
+}
+
+// pdfWrap greedily wraps s to width, the way a simple text terminal would;
+// there's no PDF text layout engine here to do it for us.
+func pdfWrap(s string, width int) []string {
+	if s == "" {
+		return []string{""}
+	}
+
+	words := strings.Fields(s)
+	var lines []string
+	var cur string
+	for _, word := range words {
+		switch {
+		case cur == "":
+			cur = word
+		case len(cur)+1+len(word) > width:
+			lines = append(lines, cur)
+			cur = word
+		default:
+			cur += " " + word
+		}
+	}
+	if cur != "" {
+		lines = append(lines, cur)
+	}
+	return lines
+}
+
+func pdfPaginate(lines []string, perPage int) [][]string {
+	if len(lines) == 0 {
+		return [][]string{{}}
+	}
+	var pages [][]string
+	for len(lines) > 0 {
+		n := perPage
+		if n > len(lines) {
+			n = len(lines)
+		}
+		pages = append(pages, lines[:n])
+		lines = lines[n:]
+	}
+	return pages
+}
+
+// writePDF assembles a minimal, valid PDF 1.4 document: one Catalog, one
+// Pages tree, a shared Helvetica font resource, and one Page/content stream
+// pair per entry in pages, with a correctly offset xref table. Object
+// numbers are assigned in write order (1=Catalog, 2=Pages, 3=Font, then a
+// Page/Contents pair per page) so the offsets recorded for the xref table
+// line up with the objects as they're actually emitted.
+func writePDF(w io.Writer, pages [][]string) error {
+	var buf bytes.Buffer
+	var offsets []int
+
+	writeObj := func(body string) {
+		offsets = append(offsets, buf.Len())
+		buf.WriteString(body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	const catalogObj, pagesObj, fontObj = 1, 2, 3
+	pageObjNums := make([]int, len(pages))
+	contentObjNums := make([]int, len(pages))
+	for i := range pages {
+		pageObjNums[i] = fontObj + 1 + i*2
+		contentObjNums[i] = pageObjNums[i] + 1
+	}
+
+	writeObj(fmt.Sprintf("%d 0 obj\n<< /Type /Catalog /Pages %d 0 R >>\nendobj\n", catalogObj, pagesObj))
+
+	kids := make([]string, len(pages))
+	for i, n := range pageObjNums {
+		kids[i] = fmt.Sprintf("%d 0 R", n)
+	}
+	writeObj(fmt.Sprintf("%d 0 obj\n<< /Type /Pages /Kids [%s] /Count %d >>\nendobj\n", pagesObj, strings.Join(kids, " "), len(pages)))
+
+	writeObj(fmt.Sprintf("%d 0 obj\n<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>\nendobj\n", fontObj))
+
+	for i, pageLines := range pages {
+		content := pdfContentStream(pageLines)
+		writeObj(fmt.Sprintf(
+			"%d 0 obj\n<< /Type /Page /Parent %d 0 R /Resources << /Font << /F1 %d 0 R >> >> /MediaBox [0 0 612 792] /Contents %d 0 R >>\nendobj\n",
+			pageObjNums[i], pagesObj, fontObj, contentObjNums[i],
+		))
+		writeObj(fmt.Sprintf("%d 0 obj\n<< /Length %d >>\nstream\n%sendstream\nendobj\n", contentObjNums[i], len(content), content))
+	}
+
+	xrefStart := buf.Len()
+	totalObjs := len(offsets)
+	buf.WriteString(fmt.Sprintf("xref\n0 %d\n", totalObjs+1))
+	buf.WriteString("0000000000 65535 f \n")
+	for _, off := range offsets {
+		buf.WriteString(fmt.Sprintf("%010d 00000 n \n", off))
+	}
+	buf.WriteString(fmt.Sprintf("trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", totalObjs+1, catalogObj, xrefStart))
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func pdfContentStream(lines []string) string {
+	var sb strings.Builder
+	sb.WriteString("BT\n")
+	fmt.Fprintf(&sb, "/F1 %d Tf\n", pdfFontSize)
+	fmt.Fprintf(&sb, "%d TL\n", pdfLineHeight)
+	fmt.Fprintf(&sb, "%d %d Td\n", pdfLeftMargin, pdfTopMargin)
+	for i, line := range lines {
+		if i > 0 {
+			sb.WriteString("T*\n")
+		}
+		fmt.Fprintf(&sb, "(%s) Tj\n", pdfEscape(line))
+	}
+	sb.WriteString("ET\n")
+	return sb.String()
+}
+
+// pdfEscape escapes the three characters PDF literal strings treat
+// specially: backslash and the two parentheses.
+func pdfEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "(", `\(`)
+	s = strings.ReplaceAll(s, ")", `\)`)
+	return s
+}
@@ -0,0 +1,79 @@
+package export
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestPDFExportProducesWellFormedDocument(t *testing.T) {
+	report := models.Report{
+		TargetPath: "/repo",
+		Findings: []models.Finding{
+			{Title: "Finding", Severity: models.SeverityHigh, Description: "a description"},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := (pdfExporter{}).Export(report, &buf); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	out := buf.String()
+
+	if !strings.HasPrefix(out, "%PDF-1.4\n") {
+		t.Errorf("output doesn't start with the PDF-1.4 header, got: %q", out[:20])
+	}
+	if !strings.Contains(out, "xref\n") || !strings.Contains(out, "%%EOF\n") {
+		t.Error("output is missing the xref table or EOF marker")
+	}
+	if !strings.Contains(out, "/Type /Catalog") || !strings.Contains(out, "/Type /Pages") {
+		t.Error("output is missing the Catalog or Pages object")
+	}
+}
+
+func TestPDFWrapGreedilyFillsWidth(t *testing.T) {
+	lines := pdfWrap("one two three four", 8)
+	want := []string{"one two", "three", "four"}
+	if len(lines) != len(want) {
+		t.Fatalf("pdfWrap = %v, want %v", lines, want)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("pdfWrap[%d] = %q, want %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestPDFWrapEmptyString(t *testing.T) {
+	if got := pdfWrap("", 10); len(got) != 1 || got[0] != "" {
+		t.Errorf("pdfWrap(\"\") = %v, want a single empty line", got)
+	}
+}
+
+func TestPDFPaginateSplitsAtPageSize(t *testing.T) {
+	lines := []string{"a", "b", "c", "d", "e"}
+	pages := pdfPaginate(lines, 2)
+	if len(pages) != 3 {
+		t.Fatalf("got %d pages, want 3", len(pages))
+	}
+	if len(pages[0]) != 2 || len(pages[1]) != 2 || len(pages[2]) != 1 {
+		t.Errorf("page sizes = %v, want [2 2 1]", []int{len(pages[0]), len(pages[1]), len(pages[2])})
+	}
+}
+
+func TestPDFPaginateEmptyLinesStillProducesOnePage(t *testing.T) {
+	pages := pdfPaginate(nil, 10)
+	if len(pages) != 1 || len(pages[0]) != 0 {
+		t.Errorf("pdfPaginate(nil) = %v, want a single empty page", pages)
+	}
+}
+
+func TestPDFEscapeSpecialCharacters(t *testing.T) {
+	got := pdfEscape(`a(b)c\d`)
+	want := `a\(b\)c\\d`
+	if got != want {
+		t.Errorf("pdfEscape = %q, want %q", got, want)
+	}
+}
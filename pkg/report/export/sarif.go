@@ -0,0 +1,17 @@
+package export
+
+import (
+	"io"
+
+	"github.com/detective-cli/detective/pkg/models"
+	"github.com/detective-cli/detective/pkg/report/sarif"
+)
+
+// sarifExporter adapts the existing pkg/report/sarif writer to the Exporter
+// interface so it can be selected through --report-formats alongside the
+// other formats in this package.
+type sarifExporter struct{}
+
+func (sarifExporter) Export(report models.Report, w io.Writer) error {
+	return sarif.WriteSARIF(w, report.Findings, report.Evidence)
+}
@@ -0,0 +1,22 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestSarifExporterDelegatesToPkgReportSarif(t *testing.T) {
+	report := models.Report{
+		Findings: []models.Finding{{Title: "Finding", Severity: models.SeverityMedium}},
+	}
+
+	var buf bytes.Buffer
+	if err := (sarifExporter{}).Export(report, &buf); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(`"version": "2.1.0"`)) {
+		t.Errorf("output doesn't look like a SARIF 2.1.0 document:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,76 @@
+package sarif
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+// WriteDiffSARIF serializes a models.FindingsDiff as SARIF 2.1.0, covering
+// only the findings a baseline gate cares about: newly Added findings and
+// the current side of each severity/description Changed finding. Each
+// result's properties bag carries a "diffStatus" of "new" or
+// "severity-changed" so downstream tooling can tell the two apart without
+// re-deriving the diff. Resolved and Persisted findings are deliberately
+// excluded — a diff gate watches for regressions, not everything still true.
+func WriteDiffSARIF(w io.Writer, diff models.FindingsDiff, evidence models.Evidence) error {
+	findings := make([]models.Finding, 0, len(diff.Added)+len(diff.Changed))
+	statuses := make([]string, 0, len(diff.Added)+len(diff.Changed))
+
+	for _, f := range diff.Added {
+		findings = append(findings, f)
+		statuses = append(statuses, "new")
+	}
+	for _, c := range diff.Changed {
+		findings = append(findings, c.Current)
+		statuses = append(statuses, "severity-changed")
+	}
+
+	doc := sarifLog{
+		Schema:  schemaURI,
+		Version: version,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           toolName,
+						InformationURI: toolInfoURI,
+						Version:        toolVersion,
+						Rules:          buildRules(findings),
+					},
+				},
+				Results: buildDiffResults(evidence.Git.RepoPath, findings, statuses),
+			},
+		},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// buildDiffResults is buildResults plus a "diffStatus" property per result;
+// statuses is parallel to findings.
+func buildDiffResults(rootPath string, findings []models.Finding, statuses []string) []sarifResult {
+	results := []sarifResult{}
+
+	for i, f := range findings {
+		properties := propertiesFor(f)
+		if properties == nil {
+			properties = map[string]interface{}{}
+		}
+		properties["diffStatus"] = statuses[i]
+
+		results = append(results, sarifResult{
+			RuleID:       ruleID(f),
+			Level:        severityToSARIFLevel(f.Severity),
+			Message:      sarifText{Text: f.Description},
+			Locations:    locationsFor(rootPath, f.Evidence),
+			Fingerprints: fingerprintsFor(f),
+			Properties:   properties,
+		})
+	}
+
+	return results
+}
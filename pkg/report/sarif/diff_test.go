@@ -0,0 +1,80 @@
+package sarif
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestWriteDiffSARIFOnlyIncludesAddedAndChanged(t *testing.T) {
+	diff := models.FindingsDiff{
+		Added: []models.Finding{
+			{ProbeID: "newProbe", Title: "New Finding", Severity: models.SeverityHigh},
+		},
+		Resolved: []models.Finding{
+			{ProbeID: "resolvedProbe", Title: "Resolved Finding", Severity: models.SeverityLow},
+		},
+		Persisted: []models.Finding{
+			{ProbeID: "persistedProbe", Title: "Persisted Finding", Severity: models.SeverityMedium},
+		},
+		Changed: []models.FindingChange{
+			{
+				Previous: models.Finding{ProbeID: "changedProbe", Title: "Changed Finding", Severity: models.SeverityLow},
+				Current:  models.Finding{ProbeID: "changedProbe", Title: "Changed Finding", Severity: models.SeverityCritical},
+			},
+		},
+	}
+	evidence := models.Evidence{Git: models.GitEvidence{RepoPath: "/repo"}}
+
+	var buf bytes.Buffer
+	if err := WriteDiffSARIF(&buf, diff, evidence); err != nil {
+		t.Fatalf("WriteDiffSARIF: %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	run := doc["runs"].([]interface{})[0].(map[string]interface{})
+	results := run["results"].([]interface{})
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2 (Added + Changed.Current only, Resolved/Persisted excluded)", len(results))
+	}
+
+	added := results[0].(map[string]interface{})
+	if added["ruleId"] != "newProbe" {
+		t.Errorf("first result ruleId = %v, want newProbe", added["ruleId"])
+	}
+	if got := added["properties"].(map[string]interface{})["diffStatus"]; got != "new" {
+		t.Errorf("diffStatus = %v, want new", got)
+	}
+
+	changed := results[1].(map[string]interface{})
+	if changed["ruleId"] != "changedProbe" {
+		t.Errorf("second result ruleId = %v, want changedProbe", changed["ruleId"])
+	}
+	if changed["level"] != "error" {
+		t.Errorf("level = %v, want error (Current severity is critical)", changed["level"])
+	}
+	if got := changed["properties"].(map[string]interface{})["diffStatus"]; got != "severity-changed" {
+		t.Errorf("diffStatus = %v, want severity-changed", got)
+	}
+}
+
+func TestWriteDiffSARIFEmptyDiffProducesNoResults(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteDiffSARIF(&buf, models.FindingsDiff{}, models.Evidence{}); err != nil {
+		t.Fatalf("WriteDiffSARIF: %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	run := doc["runs"].([]interface{})[0].(map[string]interface{})
+	if results, ok := run["results"].([]interface{}); ok && len(results) != 0 {
+		t.Errorf("got %d results for an empty diff, want 0", len(results))
+	}
+}
@@ -0,0 +1,267 @@
+// Package sarif serializes a detective scan's findings as SARIF 2.1.0, the
+// format GitHub code scanning, GitLab, and VS Code's SARIF viewer all
+// ingest. Unlike internal/security's SARIF writer, which only covers
+// SecurityEvidence, this package reports every finding the probe engine
+// produced (see pkg/inference/probes and internal/inference/probes): each
+// distinct ProbeID becomes a SARIF rule, and each finding becomes a
+// result carrying fingerprints keyed by its models.FindingSignature
+// algorithms, so GitHub/GitLab can deduplicate the same finding across
+// scans the same way inference.DiffFindings does locally.
+package sarif
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+const (
+	version     = "2.1.0"
+	schemaURI   = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+	toolName    = "detective"
+	toolInfoURI = "https://github.com/detective-cli/detective"
+	toolVersion = "dev"
+)
+
+// evidenceLocation matches the "file:line - description" shape that
+// internal/inference's buildSecretEvidence/buildSecurityRiskEvidence format
+// security findings' Evidence into. Findings whose Evidence doesn't match
+// (most non-security findings) are reported without a physicalLocation.
+var evidenceLocation = regexp.MustCompile(`^(.+):(\d+) - `)
+
+// WriteSARIF serializes findings as a SARIF 2.1.0 log: one rule per
+// distinct ProbeID, one result per finding. evidence.Git.RepoPath, when
+// set, is used to make artifact URIs repo-relative rather than absolute,
+// matching what GitHub code scanning and GitLab's SAST ingestion expect.
+func WriteSARIF(w io.Writer, findings []models.Finding, evidence models.Evidence) error {
+	doc := sarifLog{
+		Schema:  schemaURI,
+		Version: version,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           toolName,
+						InformationURI: toolInfoURI,
+						Version:        toolVersion,
+						Rules:          buildRules(findings),
+					},
+				},
+				Results: buildResults(evidence.Git.RepoPath, findings),
+			},
+		},
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// buildRules emits one rule per distinct ProbeID, in first-seen order, so
+// the tool.driver.rules block is deterministic across runs regardless of
+// map iteration order. Findings with no ProbeID (not rendered through
+// pkg/inference/probes) fall back to a rule ID derived from their Title.
+func buildRules(findings []models.Finding) []sarifRule {
+	var rules []sarifRule
+	seen := map[string]bool{}
+
+	for _, f := range findings {
+		id := ruleID(f)
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		rules = append(rules, sarifRule{
+			ID:               id,
+			Name:             f.Title,
+			ShortDescription: sarifText{Text: f.Title},
+			Help:             sarifText{Text: f.Description},
+			DefaultConfiguration: sarifConfig{
+				Level: severityToSARIFLevel(f.Severity),
+			},
+		})
+	}
+
+	return rules
+}
+
+func buildResults(rootPath string, findings []models.Finding) []sarifResult {
+	results := []sarifResult{}
+
+	for _, f := range findings {
+		result := sarifResult{
+			RuleID:       ruleID(f),
+			Level:        severityToSARIFLevel(f.Severity),
+			Message:      sarifText{Text: f.Description},
+			Locations:    locationsFor(rootPath, f.Evidence),
+			Fingerprints: fingerprintsFor(f),
+			Properties:   propertiesFor(f),
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// ruleID returns f.ProbeID, falling back to its Title for findings not
+// backed by a probe definition (ProbeID empty).
+func ruleID(f models.Finding) string {
+	if f.ProbeID != "" {
+		return f.ProbeID
+	}
+	return f.Title
+}
+
+// locationsFor extracts a physicalLocation from every evidence line
+// matching evidenceLocation, so security findings (whose evidence lines
+// are "file:line - description") get a location per affected site.
+// Findings whose evidence doesn't carry a file/line report no locations,
+// same as a dependency-vulnerability result in the security package's
+// SARIF writer.
+func locationsFor(rootPath string, lines []string) []sarifLocation {
+	var locations []sarifLocation
+	for _, line := range lines {
+		m := evidenceLocation.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		lineNum, err := strconv.Atoi(m[2])
+		if err != nil {
+			continue
+		}
+		locations = append(locations, sarifLocationFor(rootPath, m[1], lineNum))
+	}
+	return locations
+}
+
+func sarifLocationFor(rootPath, file string, line int) sarifLocation {
+	uri := file
+	if rootPath != "" {
+		if rel, err := filepath.Rel(rootPath, file); err == nil {
+			uri = filepath.ToSlash(rel)
+		}
+	}
+	return sarifLocation{
+		PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: uri},
+			Region:           sarifRegion{StartLine: line},
+		},
+	}
+}
+
+// fingerprintsFor turns a finding's models.FindingSignature list into
+// SARIF's partialFingerprints, keyed by algorithm name, so GitHub/GitLab
+// can match the same finding across runs the same way
+// inference.DiffFindings does.
+func fingerprintsFor(f models.Finding) map[string]string {
+	if len(f.Signatures) == 0 {
+		return nil
+	}
+	fingerprints := make(map[string]string, len(f.Signatures))
+	for _, sig := range f.Signatures {
+		fingerprints["detective/"+string(sig.Algorithm)] = sig.Value
+	}
+	return fingerprints
+}
+
+// propertiesFor surfaces a finding's probe/outcome/values as SARIF's
+// standard properties bag, the spec's escape hatch for tool-specific data
+// that doesn't fit SARIF's own result schema. Findings not backed by a
+// probe (no ProbeID and no Values) get no properties bag at all.
+func propertiesFor(f models.Finding) map[string]interface{} {
+	if f.ProbeID == "" && f.Outcome == "" && len(f.Values) == 0 {
+		return nil
+	}
+
+	properties := map[string]interface{}{}
+	if f.ProbeID != "" {
+		properties["probe"] = f.ProbeID
+	}
+	if f.Outcome != "" {
+		properties["outcome"] = string(f.Outcome)
+	}
+	if len(f.Values) > 0 {
+		properties["values"] = f.Values
+	}
+	return properties
+}
+
+func severityToSARIFLevel(sev models.Severity) string {
+	switch sev {
+	case models.SeverityCritical, models.SeverityHigh:
+		return "error"
+	case models.SeverityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string      `json:"id"`
+	Name                 string      `json:"name"`
+	ShortDescription     sarifText   `json:"shortDescription"`
+	Help                 sarifText   `json:"help"`
+	DefaultConfiguration sarifConfig `json:"defaultConfiguration"`
+}
+
+type sarifConfig struct {
+	Level string `json:"level"`
+}
+
+type sarifText struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID       string                 `json:"ruleId"`
+	Level        string                 `json:"level"`
+	Message      sarifText              `json:"message"`
+	Locations    []sarifLocation        `json:"locations,omitempty"`
+	Fingerprints map[string]string      `json:"partialFingerprints,omitempty"`
+	Properties   map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
@@ -0,0 +1,139 @@
+package sarif
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/detective-cli/detective/pkg/models"
+)
+
+func TestWriteSARIFStructure(t *testing.T) {
+	findings := []models.Finding{
+		{
+			ProbeID:     "hardcodedSecrets",
+			Title:       "Hardcoded Secret Detected",
+			Description: "A secret was found",
+			Severity:    models.SeverityHigh,
+			Evidence:    []string{"/repo/config.go:12 - hardcoded api key"},
+			Signatures:  []models.FindingSignature{{Algorithm: "location", Value: "config.go:12"}},
+			Outcome:     models.OutcomeFail,
+			Values:      map[string]string{"filePath": "/repo/config.go"},
+		},
+		{
+			Title:       "No Test Files Detected",
+			Description: "Project has no tests",
+			Severity:    models.SeverityMedium,
+		},
+	}
+	evidence := models.Evidence{Git: models.GitEvidence{RepoPath: "/repo"}}
+
+	var buf bytes.Buffer
+	if err := WriteSARIF(&buf, findings, evidence); err != nil {
+		t.Fatalf("WriteSARIF: %v", err)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not valid JSON: %v", err)
+	}
+	if doc["version"] != "2.1.0" {
+		t.Errorf("version = %v, want 2.1.0", doc["version"])
+	}
+
+	runs := doc["runs"].([]interface{})
+	run := runs[0].(map[string]interface{})
+	rules := run["tool"].(map[string]interface{})["driver"].(map[string]interface{})["rules"].([]interface{})
+	if len(rules) != 2 {
+		t.Fatalf("got %d rules, want 2 (one per distinct ruleID)", len(rules))
+	}
+
+	results := run["results"].([]interface{})
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+
+	first := results[0].(map[string]interface{})
+	if first["ruleId"] != "hardcodedSecrets" {
+		t.Errorf("first result ruleId = %v, want hardcodedSecrets", first["ruleId"])
+	}
+	locs := first["locations"].([]interface{})
+	if len(locs) != 1 {
+		t.Fatalf("got %d locations, want 1", len(locs))
+	}
+	uri := locs[0].(map[string]interface{})["physicalLocation"].(map[string]interface{})["artifactLocation"].(map[string]interface{})["uri"]
+	if uri != "config.go" {
+		t.Errorf("artifactLocation.uri = %v, want repo-relative config.go", uri)
+	}
+
+	second := results[1].(map[string]interface{})
+	if second["ruleId"] != "No Test Files Detected" {
+		t.Errorf("second result ruleId = %v, want its Title fallback", second["ruleId"])
+	}
+	if _, ok := second["locations"]; ok {
+		t.Errorf("second result has locations %v, want none (no evidence line to parse)", second["locations"])
+	}
+}
+
+func TestRuleIDFallsBackToTitle(t *testing.T) {
+	f := models.Finding{Title: "Some Finding"}
+	if got := ruleID(f); got != "Some Finding" {
+		t.Errorf("ruleID = %q, want Title fallback", got)
+	}
+
+	f.ProbeID = "someProbe"
+	if got := ruleID(f); got != "someProbe" {
+		t.Errorf("ruleID = %q, want ProbeID", got)
+	}
+}
+
+func TestSeverityToSARIFLevel(t *testing.T) {
+	tests := []struct {
+		sev  models.Severity
+		want string
+	}{
+		{models.SeverityCritical, "error"},
+		{models.SeverityHigh, "error"},
+		{models.SeverityMedium, "warning"},
+		{models.SeverityLow, "note"},
+	}
+	for _, tt := range tests {
+		if got := severityToSARIFLevel(tt.sev); got != tt.want {
+			t.Errorf("severityToSARIFLevel(%v) = %q, want %q", tt.sev, got, tt.want)
+		}
+	}
+}
+
+func TestFingerprintsForEmptyWithNoSignatures(t *testing.T) {
+	if got := fingerprintsFor(models.Finding{}); got != nil {
+		t.Errorf("fingerprintsFor(no signatures) = %v, want nil", got)
+	}
+
+	f := models.Finding{Signatures: []models.FindingSignature{{Algorithm: "hash", Value: "abc"}}}
+	got := fingerprintsFor(f)
+	if got["detective/hash"] != "abc" {
+		t.Errorf("fingerprintsFor = %v, want detective/hash=abc", got)
+	}
+}
+
+func TestPropertiesForNilWhenFindingHasNoProbeData(t *testing.T) {
+	if got := propertiesFor(models.Finding{}); got != nil {
+		t.Errorf("propertiesFor(bare finding) = %v, want nil", got)
+	}
+
+	f := models.Finding{ProbeID: "p1", Outcome: models.OutcomeFail, Values: map[string]string{"k": "v"}}
+	got := propertiesFor(f)
+	if got["probe"] != "p1" || got["outcome"] != "Fail" {
+		t.Errorf("propertiesFor = %+v, want probe=p1 outcome=Fail", got)
+	}
+}
+
+func TestLocationsForIgnoresUnparseableEvidence(t *testing.T) {
+	locs := locationsFor("/repo", []string{"5 TODO markers found", "/repo/main.go:10 - issue"})
+	if len(locs) != 1 {
+		t.Fatalf("got %d locations, want 1 (only the file:line-shaped line)", len(locs))
+	}
+	if locs[0].PhysicalLocation.Region.StartLine != 10 {
+		t.Errorf("StartLine = %d, want 10", locs[0].PhysicalLocation.Region.StartLine)
+	}
+}